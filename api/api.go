@@ -36,6 +36,7 @@ func Serve(router *mux.Router, store *storage.Storage, feedHandler *feed.Handler
 	sr.HandleFunc("/feeds/{feedID}", handler.updateFeed).Methods("PUT")
 	sr.HandleFunc("/feeds/{feedID}", handler.removeFeed).Methods("DELETE")
 	sr.HandleFunc("/feeds/{feedID}/icon", handler.feedIcon).Methods("GET")
+	sr.HandleFunc("/feeds/{feedID}/history", handler.getFeedHistory).Methods("GET")
 	sr.HandleFunc("/export", handler.exportFeeds).Methods("GET")
 	sr.HandleFunc("/import", handler.importFeeds).Methods("POST")
 	sr.HandleFunc("/feeds/{feedID}/entries", handler.getFeedEntries).Methods("GET")
@@ -43,5 +44,23 @@ func Serve(router *mux.Router, store *storage.Storage, feedHandler *feed.Handler
 	sr.HandleFunc("/entries", handler.getEntries).Methods("GET")
 	sr.HandleFunc("/entries", handler.setEntryStatus).Methods("PUT")
 	sr.HandleFunc("/entries/{entryID}", handler.getEntry).Methods("GET")
+	sr.HandleFunc("/entries/{entryID}/revisions", handler.getEntryRevisions).Methods("GET")
 	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleBookmark).Methods("PUT")
+	sr.HandleFunc("/entries/{entryID}/tags", handler.updateEntryTags).Methods("PUT")
+	sr.HandleFunc("/enclosures/{enclosureID}", handler.getEnclosure).Methods("GET")
+	sr.HandleFunc("/enclosures/{enclosureID}", handler.updateEnclosure).Methods("PUT")
+	sr.HandleFunc("/tags", handler.getTags).Methods("GET")
+	sr.HandleFunc("/rules", handler.getRules).Methods("GET")
+	sr.HandleFunc("/rules", handler.createRule).Methods("POST")
+	sr.HandleFunc("/rules/{ruleID}", handler.getRule).Methods("GET")
+	sr.HandleFunc("/rules/{ruleID}", handler.updateRule).Methods("PUT")
+	sr.HandleFunc("/rules/{ruleID}", handler.removeRule).Methods("DELETE")
+	sr.HandleFunc("/rules/{ruleID}/apply", handler.applyRule).Methods("POST")
+	sr.HandleFunc("/site-rules", handler.getSiteRules).Methods("GET")
+	sr.HandleFunc("/site-rules", handler.createSiteRule).Methods("POST")
+	sr.HandleFunc("/site-rules/export", handler.exportSiteRules).Methods("GET")
+	sr.HandleFunc("/site-rules/import", handler.importSiteRules).Methods("POST")
+	sr.HandleFunc("/site-rules/{siteRuleID}", handler.getSiteRule).Methods("GET")
+	sr.HandleFunc("/site-rules/{siteRuleID}", handler.updateSiteRule).Methods("PUT")
+	sr.HandleFunc("/site-rules/{siteRuleID}", handler.removeSiteRule).Methods("DELETE")
 }
@@ -0,0 +1,58 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) getEnclosure(w http.ResponseWriter, r *http.Request) {
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+	enclosure, err := h.store.EnclosureByID(request.UserID(r), enclosureID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, enclosure)
+}
+
+func (h *handler) updateEnclosure(w http.ResponseWriter, r *http.Request) {
+	progression, err := decodeEnclosureModificationPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+
+	enclosure, err := h.store.EnclosureByID(userID, enclosureID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.UpdateEnclosureMediaProgression(userID, enclosure.ID, progression); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	enclosure.MediaProgression = progression
+	json.Created(w, r, enclosure)
+}
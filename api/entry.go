@@ -56,6 +56,33 @@ func (h *handler) getEntry(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, entry)
 }
 
+func (h *handler) getEntryRevisions(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	userID := request.UserID(r)
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+
+	count, err := builder.CountEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if count == 0 {
+		json.NotFound(w, r)
+		return
+	}
+
+	revisions, err := h.store.EntryRevisions(userID, entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, revisions)
+}
+
 func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 
@@ -191,6 +218,45 @@ func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
 	json.NoContent(w, r)
 }
 
+func (h *handler) updateEntryTags(w http.ResponseWriter, r *http.Request) {
+	titles, err := decodeEntryTagsPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.SetEntryTags(userID, entry.ID, model.NormalizeTags(titles)); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	entry, err = builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, entry.Tags)
+}
+
 func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 	beforeEntryID := request.QueryInt64Param(r, "before_entry_id", 0)
 	if beforeEntryID != 0 {
@@ -220,4 +286,17 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 	if searchQuery != "" {
 		builder.WithSearchQuery(searchQuery)
 	}
+
+	if request.HasQueryParam(r, "min_reading_time") {
+		builder.WithMinReadingTime(request.QueryIntParam(r, "min_reading_time", 0))
+	}
+
+	if request.HasQueryParam(r, "max_reading_time") {
+		builder.WithMaxReadingTime(request.QueryIntParam(r, "max_reading_time", 0))
+	}
+
+	tag := request.QueryStringParam(r, "tag", "")
+	if tag != "" {
+		builder.WithTag(tag)
+	}
 }
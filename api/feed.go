@@ -8,8 +8,12 @@ import (
 	"errors"
 	"net/http"
 
+	"miniflux.app/config"
+	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/filter"
 )
 
 func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
@@ -29,6 +33,18 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := validateRequestHeaders(feedInfo.Headers, feedInfo.Cookie); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if feedInfo.ProxyURL != "" {
+		if err := client.ValidateProxyURL(feedInfo.ProxyURL); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
 	userID := request.UserID(r)
 
 	if h.store.FeedURLExists(userID, feedInfo.FeedURL) {
@@ -49,6 +65,9 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 		feedInfo.UserAgent,
 		feedInfo.Username,
 		feedInfo.Password,
+		feedInfo.ProxyURL,
+		feedInfo.Headers,
+		feedInfo.Cookie,
 	)
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -101,8 +120,26 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	wasDisabled := originalFeed.Disabled
 	feedChanges.Update(originalFeed)
 
+	if originalFeed.ProxyURL != "" {
+		if err := client.ValidateProxyURL(originalFeed.ProxyURL); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	if err := validateRequestHeaders(originalFeed.Headers, originalFeed.Cookie); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := validateFilterRules(originalFeed); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
 	if !h.store.CategoryExists(userID, originalFeed.Category.ID) {
 		json.BadRequest(w, r, errors.New("This category_id doesn't exists or doesn't belongs to this user"))
 		return
@@ -113,6 +150,10 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if originalFeed.Disabled && !wasDisabled {
+		h.feedHandler.UnsubscribeFromHub(originalFeed)
+	}
+
 	originalFeed, err = h.store.FeedByID(userID, feedID)
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -148,7 +189,7 @@ func (h *handler) getFeed(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, feed)
 }
 
-func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
+func (h *handler) getFeedHistory(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	userID := request.UserID(r)
 
@@ -157,6 +198,33 @@ func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fetches, err := h.store.FeedFetches(userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, fetches)
+}
+
+func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID := request.UserID(r)
+
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	// The hub may still verify the request after the removal, it is a best effort.
+	h.feedHandler.UnsubscribeFromHub(feed)
+
 	if err := h.store.RemoveFeed(userID, feedID); err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -164,3 +232,27 @@ func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 
 	json.NoContent(w, r)
 }
+
+func validateRequestHeaders(headers map[string]string, cookie string) error {
+	if len(headers) == 0 && cookie == "" {
+		return nil
+	}
+
+	if !config.Opts.HasEncryptionKey() {
+		return errors.New("Custom headers and cookies cannot be saved because no encryption key is configured (ENCRYPTION_KEY)")
+	}
+
+	return client.ValidateHeaders(headers, cookie)
+}
+
+func validateFilterRules(feed *model.Feed) error {
+	if _, err := filter.ParseRules(feed.KeepRules); err != nil {
+		return err
+	}
+
+	if _, err := filter.ParseRules(feed.BlockRules); err != nil {
+		return err
+	}
+
+	return model.ValidateFilterAction(feed.FilterAction)
+}
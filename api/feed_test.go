@@ -0,0 +1,44 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func TestValidateRequestHeadersWithoutEncryptionKey(t *testing.T) {
+	os.Clearenv()
+	config.Opts = config.NewOptions()
+
+	if err := validateRequestHeaders(nil, ""); err != nil {
+		t.Errorf(`Feeds without custom headers should not require an encryption key, got %v`, err)
+	}
+
+	if err := validateRequestHeaders(map[string]string{"Authorization": "Bearer token"}, ""); err == nil {
+		t.Error(`Custom headers should be rejected without encryption key`)
+	}
+
+	if err := validateRequestHeaders(nil, "session=abc"); err == nil {
+		t.Error(`A cookie should be rejected without encryption key`)
+	}
+}
+
+func TestValidateRequestHeadersWithEncryptionKey(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "secret")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if err := validateRequestHeaders(map[string]string{"Authorization": "Bearer token"}, "session=abc"); err != nil {
+		t.Errorf(`Custom headers should be accepted with an encryption key, got %v`, err)
+	}
+}
@@ -6,6 +6,7 @@ package api // import "miniflux.app/api"
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 
@@ -24,32 +25,48 @@ type entriesResponse struct {
 }
 
 type feedCreation struct {
-	FeedURL    string `json:"feed_url"`
-	CategoryID int64  `json:"category_id"`
-	UserAgent  string `json:"user_agent"`
-	Username   string `json:"username"`
-	Password   string `json:"password"`
-	Crawler    bool   `json:"crawler"`
+	FeedURL    string            `json:"feed_url"`
+	CategoryID int64             `json:"category_id"`
+	UserAgent  string            `json:"user_agent"`
+	Username   string            `json:"username"`
+	Password   string            `json:"password"`
+	ProxyURL   string            `json:"proxy_url"`
+	Headers    map[string]string `json:"headers"`
+	Cookie     string            `json:"cookie"`
+	Crawler    bool              `json:"crawler"`
 }
 
 type subscriptionDiscovery struct {
-	URL       string `json:"url"`
-	UserAgent string `json:"user_agent"`
-	Username  string `json:"username"`
-	Password  string `json:"password"`
+	URL       string            `json:"url"`
+	UserAgent string            `json:"user_agent"`
+	Username  string            `json:"username"`
+	Password  string            `json:"password"`
+	ProxyURL  string            `json:"proxy_url"`
+	Headers   map[string]string `json:"headers"`
+	Cookie    string            `json:"cookie"`
 }
 
 type feedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
+	FeedURL                *string            `json:"feed_url"`
+	SiteURL                *string            `json:"site_url"`
+	Title                  *string            `json:"title"`
+	ScraperRules           *string            `json:"scraper_rules"`
+	RewriteRules           *string            `json:"rewrite_rules"`
+	KeepRules              *string            `json:"keep_rules"`
+	BlockRules             *string            `json:"block_rules"`
+	FilterAction           *string            `json:"filter_action"`
+	Crawler                *bool              `json:"crawler"`
+	MarkUnreadOnUpdate     *bool              `json:"mark_unread_on_update"`
+	KeepTrackingParameters *bool              `json:"keep_tracking_parameters"`
+	ResolveFinalURL        *bool              `json:"resolve_final_url"`
+	Disabled               *bool              `json:"disabled"`
+	UserAgent              *string            `json:"user_agent"`
+	ProxyURL               *string            `json:"proxy_url"`
+	Username               *string            `json:"username"`
+	Password               *string            `json:"password"`
+	Headers                *map[string]string `json:"headers"`
+	Cookie                 *string            `json:"cookie"`
+	CategoryID             *int64             `json:"category_id"`
 }
 
 func (f *feedModification) Update(feed *model.Feed) {
@@ -73,14 +90,46 @@ func (f *feedModification) Update(feed *model.Feed) {
 		feed.RewriteRules = *f.RewriteRules
 	}
 
+	if f.KeepRules != nil {
+		feed.KeepRules = *f.KeepRules
+	}
+
+	if f.BlockRules != nil {
+		feed.BlockRules = *f.BlockRules
+	}
+
+	if f.FilterAction != nil {
+		feed.FilterAction = *f.FilterAction
+	}
+
 	if f.Crawler != nil {
 		feed.Crawler = *f.Crawler
 	}
 
+	if f.MarkUnreadOnUpdate != nil {
+		feed.MarkUnreadOnUpdate = *f.MarkUnreadOnUpdate
+	}
+
+	if f.KeepTrackingParameters != nil {
+		feed.KeepTrackingParameters = *f.KeepTrackingParameters
+	}
+
+	if f.ResolveFinalURL != nil {
+		feed.ResolveFinalURL = *f.ResolveFinalURL
+	}
+
+	if f.Disabled != nil {
+		feed.Disabled = *f.Disabled
+	}
+
 	if f.UserAgent != nil {
 		feed.UserAgent = *f.UserAgent
 	}
 
+	if f.ProxyURL != nil {
+		feed.ProxyURL = *f.ProxyURL
+	}
+
 	if f.Username != nil {
 		feed.Username = *f.Username
 	}
@@ -89,19 +138,32 @@ func (f *feedModification) Update(feed *model.Feed) {
 		feed.Password = *f.Password
 	}
 
+	if f.Headers != nil {
+		feed.Headers = *f.Headers
+		feed.EncryptedHeaders = ""
+	}
+
+	if f.Cookie != nil {
+		feed.Cookie = *f.Cookie
+		feed.EncryptedCookie = ""
+	}
+
 	if f.CategoryID != nil && *f.CategoryID > 0 {
 		feed.Category.ID = *f.CategoryID
 	}
 }
 
 type userModification struct {
-	Username       *string `json:"username"`
-	Password       *string `json:"password"`
-	IsAdmin        *bool   `json:"is_admin"`
-	Theme          *string `json:"theme"`
-	Language       *string `json:"language"`
-	Timezone       *string `json:"timezone"`
-	EntryDirection *string `json:"entry_sorting_direction"`
+	Username                  *string `json:"username"`
+	Password                  *string `json:"password"`
+	IsAdmin                   *bool   `json:"is_admin"`
+	Theme                     *string `json:"theme"`
+	Language                  *string `json:"language"`
+	Timezone                  *string `json:"timezone"`
+	EntryDirection            *string `json:"entry_sorting_direction"`
+	DuplicatePolicy           *string `json:"duplicate_policy"`
+	DuplicateMatchTitle       *bool   `json:"duplicate_match_title"`
+	MarkReadOnMediaCompletion *bool   `json:"mark_read_on_media_completion"`
 }
 
 func (u *userModification) Update(user *model.User) {
@@ -132,6 +194,18 @@ func (u *userModification) Update(user *model.User) {
 	if u.EntryDirection != nil {
 		user.EntryDirection = *u.EntryDirection
 	}
+
+	if u.DuplicatePolicy != nil {
+		user.DuplicatePolicy = *u.DuplicatePolicy
+	}
+
+	if u.DuplicateMatchTitle != nil {
+		user.DuplicateMatchTitle = *u.DuplicateMatchTitle
+	}
+
+	if u.MarkReadOnMediaCompletion != nil {
+		user.MarkReadOnMediaCompletion = *u.MarkReadOnMediaCompletion
+	}
 }
 
 func decodeUserModificationPayload(r io.ReadCloser) (*userModification, error) {
@@ -186,6 +260,44 @@ func decodeEntryStatusPayload(r io.ReadCloser) ([]int64, string, error) {
 	return p.EntryIDs, p.Status, nil
 }
 
+func decodeEntryTagsPayload(r io.ReadCloser) ([]string, error) {
+	type payload struct {
+		Tags []string `json:"tags"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("Unable to decode entry tags JSON object: %v", err)
+	}
+
+	return p.Tags, nil
+}
+
+func decodeEnclosureModificationPayload(r io.ReadCloser) (int, error) {
+	type payload struct {
+		MediaProgression *int `json:"media_progression"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return 0, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if p.MediaProgression == nil {
+		return 0, errors.New("the media progression is mandatory")
+	}
+
+	if *p.MediaProgression < 0 {
+		return 0, errors.New("the media progression must be a positive number of seconds")
+	}
+
+	return *p.MediaProgression, nil
+}
+
 func decodeFeedCreationPayload(r io.ReadCloser) (*feedCreation, error) {
 	defer r.Close()
 
@@ -221,3 +333,39 @@ func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 
 	return &category, nil
 }
+
+func decodeRulePayload(r io.ReadCloser) (*model.Rule, error) {
+	var rule model.Rule
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("Unable to decode rule JSON object: %v", err)
+	}
+
+	return &rule, nil
+}
+
+func decodeSiteRulePayload(r io.ReadCloser) (*model.SiteRule, error) {
+	var rule model.SiteRule
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("Unable to decode site rule JSON object: %v", err)
+	}
+
+	return &rule, nil
+}
+
+func decodeSiteRulesPayload(r io.ReadCloser) (model.SiteRules, error) {
+	var rules model.SiteRules
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("Unable to decode site rules JSON array: %v", err)
+	}
+
+	return rules, nil
+}
@@ -5,6 +5,8 @@
 package api // import "miniflux.app/api"
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"miniflux.app/model"
@@ -170,6 +172,89 @@ func TestUpdateFeedCategoryWhenNotSet(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedDisabled(t *testing.T) {
+	disabled := true
+	changes := &feedModification{Disabled: &disabled}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if !feed.Disabled {
+		t.Fatal(`The feed should be disabled`)
+	}
+}
+
+func TestUpdateFeedDisabledWhenNotSet(t *testing.T) {
+	changes := &feedModification{}
+	feed := &model.Feed{Disabled: true}
+	changes.Update(feed)
+
+	if !feed.Disabled {
+		t.Fatal(`The Disabled flag should not be modified`)
+	}
+}
+
+func TestUpdateFeedProxyURL(t *testing.T) {
+	proxyURL := "socks5://127.0.0.1:9050"
+	changes := &feedModification{ProxyURL: &proxyURL}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if feed.ProxyURL != proxyURL {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.ProxyURL, proxyURL)
+	}
+}
+
+func TestUpdateFeedProxyURLWhenNotSet(t *testing.T) {
+	changes := &feedModification{}
+	feed := &model.Feed{ProxyURL: "http://127.0.0.1:3128"}
+	changes.Update(feed)
+
+	if feed.ProxyURL != "http://127.0.0.1:3128" {
+		t.Fatal(`The ProxyURL should not be modified`)
+	}
+}
+
+func TestUpdateFeedHeaders(t *testing.T) {
+	headers := map[string]string{"Authorization": "Bearer token"}
+	cookie := "session=abc"
+	changes := &feedModification{Headers: &headers, Cookie: &cookie}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if feed.Headers["Authorization"] != "Bearer token" {
+		t.Fatalf(`Unexpected headers, got %v`, feed.Headers)
+	}
+
+	if feed.Cookie != cookie {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.Cookie, cookie)
+	}
+}
+
+func TestUpdateFeedHeadersWhenNotSet(t *testing.T) {
+	changes := &feedModification{}
+	feed := &model.Feed{Headers: map[string]string{"X-Api-Key": "key"}, Cookie: "session=abc"}
+	changes.Update(feed)
+
+	if feed.Headers["X-Api-Key"] != "key" || feed.Cookie != "session=abc" {
+		t.Fatal(`The headers and the cookie should not be modified`)
+	}
+}
+
+func TestUpdateFeedHeadersWithUndecryptableValues(t *testing.T) {
+	headers := map[string]string{}
+	changes := &feedModification{Headers: &headers}
+	feed := &model.Feed{EncryptedHeaders: "old headers", EncryptedCookie: "old cookie"}
+	changes.Update(feed)
+
+	if feed.EncryptedHeaders != "" {
+		t.Error(`Clearing the headers should remove the value that could not be decrypted`)
+	}
+
+	if feed.EncryptedCookie != "old cookie" {
+		t.Error(`The cookie that could not be decrypted should be kept when it is not modified`)
+	}
+}
+
 func TestUpdateUserTheme(t *testing.T) {
 	theme := "Example 2"
 	changes := &userModification{Theme: &theme}
@@ -190,3 +275,76 @@ func TestUserThemeWhenNotSet(t *testing.T) {
 		t.Fatal(`The user Theme should not be modified`)
 	}
 }
+
+func TestUpdateUserDuplicatePolicy(t *testing.T) {
+	policy := model.DuplicatePolicyGroup
+	matchTitle := true
+	changes := &userModification{DuplicatePolicy: &policy, DuplicateMatchTitle: &matchTitle}
+	user := &model.User{DuplicatePolicy: model.DuplicatePolicyNone}
+	changes.Update(user)
+
+	if user.DuplicatePolicy != policy || !user.DuplicateMatchTitle {
+		t.Fatalf(`Unexpected values, got %q and %v`, user.DuplicatePolicy, user.DuplicateMatchTitle)
+	}
+}
+
+func TestUpdateFeedMarkUnreadOnUpdate(t *testing.T) {
+	markUnreadOnUpdate := true
+	changes := &feedModification{MarkUnreadOnUpdate: &markUnreadOnUpdate}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if !feed.MarkUnreadOnUpdate {
+		t.Fatal(`The mark_unread_on_update field should be modified`)
+	}
+}
+
+func TestUpdateFeedKeepTrackingParameters(t *testing.T) {
+	keepTrackingParameters := true
+	changes := &feedModification{KeepTrackingParameters: &keepTrackingParameters}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if !feed.KeepTrackingParameters {
+		t.Fatal(`The keep_tracking_parameters field should be modified`)
+	}
+}
+
+func TestUpdateFeedResolveFinalURL(t *testing.T) {
+	resolveFinalURL := true
+	changes := &feedModification{ResolveFinalURL: &resolveFinalURL}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if !feed.ResolveFinalURL {
+		t.Fatal(`The resolve_final_url field should be modified`)
+	}
+}
+
+func TestUpdateUserMarkReadOnMediaCompletion(t *testing.T) {
+	value := true
+	user := &model.User{}
+	changes := &userModification{MarkReadOnMediaCompletion: &value}
+	changes.Update(user)
+
+	if !user.MarkReadOnMediaCompletion {
+		t.Fatal(`The setting should be enabled`)
+	}
+}
+
+func TestDecodeEnclosureModificationPayload(t *testing.T) {
+	progression, err := decodeEnclosureModificationPayload(ioutil.NopCloser(strings.NewReader(`{"media_progression": 42}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if progression != 42 {
+		t.Errorf(`Unexpected media progression, got %d`, progression)
+	}
+
+	for _, payload := range []string{`{}`, `{"media_progression": -1}`, `invalid`} {
+		if _, err := decodeEnclosureModificationPayload(ioutil.NopCloser(strings.NewReader(payload))); err == nil {
+			t.Errorf(`The payload %s should be rejected`, payload)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/rule"
+)
+
+func (h *handler) getRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.store.Rules(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, rules)
+}
+
+func (h *handler) getRule(w http.ResponseWriter, r *http.Request) {
+	ruleID := request.RouteInt64Param(r, "ruleID")
+	rule, err := h.store.RuleByID(request.UserID(r), ruleID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, rule)
+}
+
+func (h *handler) createRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := decodeRulePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	rule.UserID = request.UserID(r)
+	if err := h.validateRule(rule); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateRule(rule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, rule)
+}
+
+func (h *handler) updateRule(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	ruleID := request.RouteInt64Param(r, "ruleID")
+
+	originalRule, err := h.store.RuleByID(userID, ruleID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if originalRule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	rule, err := decodeRulePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	rule.ID = originalRule.ID
+	rule.UserID = userID
+	rule.MatchCount = originalRule.MatchCount
+	if err := h.validateRule(rule); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.UpdateRule(rule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, rule)
+}
+
+func (h *handler) removeRule(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	ruleID := request.RouteInt64Param(r, "ruleID")
+
+	rule, err := h.store.RuleByID(userID, ruleID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveRule(userID, rule.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) applyRule(w http.ResponseWriter, r *http.Request) {
+	ruleID := request.RouteInt64Param(r, "ruleID")
+	userRule, err := h.store.RuleByID(request.UserID(r), ruleID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if userRule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	matched, err := rule.ApplyRule(h.store, userRule)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, map[string]int{"matched": matched})
+}
+
+func (h *handler) validateRule(rule *model.Rule) error {
+	if err := rule.ValidateRule(); err != nil {
+		return err
+	}
+
+	if rule.FeedID > 0 && !h.store.FeedExists(rule.UserID, rule.FeedID) {
+		return errors.New("This feed does not exist")
+	}
+
+	if rule.CategoryID > 0 && !h.store.CategoryExists(rule.UserID, rule.CategoryID) {
+		return errors.New("This category does not exist")
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) getSiteRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.store.SiteRules(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, rules)
+}
+
+func (h *handler) getSiteRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := h.findSiteRule(r)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, rule)
+}
+
+func (h *handler) createSiteRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := decodeSiteRulePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	rule.ID = 0
+	rule.UserID = 0
+	if !rule.Global {
+		rule.UserID = request.UserID(r)
+	}
+
+	if rule.Global && !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	if err := h.validateSiteRule(rule); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateSiteRule(rule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, rule)
+}
+
+func (h *handler) updateSiteRule(w http.ResponseWriter, r *http.Request) {
+	originalRule, err := h.findSiteRule(r)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if originalRule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if originalRule.Global && !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	rule, err := decodeSiteRulePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	rule.ID = originalRule.ID
+	rule.UserID = originalRule.UserID
+	rule.Global = originalRule.Global
+	if err := h.validateSiteRule(rule); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.UpdateSiteRule(rule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, rule)
+}
+
+func (h *handler) removeSiteRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := h.findSiteRule(r)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if rule.Global && !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	if err := h.store.RemoveSiteRule(rule.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+// exportSiteRules returns the rules of the user, or the global rules, without their identifiers.
+func (h *handler) exportSiteRules(w http.ResponseWriter, r *http.Request) {
+	var rules model.SiteRules
+	var err error
+
+	if request.HasQueryParam(r, "global") {
+		rules, err = h.store.GlobalSiteRules()
+	} else {
+		rules, err = h.store.UserSiteRules(request.UserID(r))
+	}
+
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, rules.Export())
+}
+
+// importSiteRules creates the rules or replaces the existing rules of the same domains.
+func (h *handler) importSiteRules(w http.ResponseWriter, r *http.Request) {
+	global := request.HasQueryParam(r, "global")
+	if global && !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	rules, err := decodeSiteRulesPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	if global {
+		userID = 0
+	}
+
+	for _, rule := range rules {
+		rule.Domain = model.NormalizeDomain(rule.Domain)
+		if err := rule.ValidateSiteRule(); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	if err := h.store.ImportSiteRules(userID, rules); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, map[string]int{"imported": len(rules)})
+}
+
+// findSiteRule returns the rule of the request when it is global or owned by the user.
+func (h *handler) findSiteRule(r *http.Request) (*model.SiteRule, error) {
+	rule, err := h.store.SiteRuleByID(request.RouteInt64Param(r, "siteRuleID"))
+	if err != nil || rule == nil {
+		return nil, err
+	}
+
+	if !rule.Global && rule.UserID != request.UserID(r) {
+		return nil, nil
+	}
+
+	return rule, nil
+}
+
+func (h *handler) validateSiteRule(rule *model.SiteRule) error {
+	rule.Domain = model.NormalizeDomain(rule.Domain)
+	if err := rule.ValidateSiteRule(); err != nil {
+		return err
+	}
+
+	if h.store.AnotherSiteRuleExists(rule) {
+		return fmt.Errorf("A rule already exists for the domain %q", rule.Domain)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ package api // import "miniflux.app/api"
 import (
 	"net/http"
 
+	"miniflux.app/http/client"
 	"miniflux.app/http/response/json"
 	"miniflux.app/reader/subscription"
 )
@@ -18,11 +19,26 @@ func (h *handler) getSubscriptions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := client.ValidateHeaders(subscriptionInfo.Headers, subscriptionInfo.Cookie); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if subscriptionInfo.ProxyURL != "" {
+		if err := client.ValidateProxyURL(subscriptionInfo.ProxyURL); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
 	subscriptions, finderErr := subscription.FindSubscriptions(
 		subscriptionInfo.URL,
 		subscriptionInfo.UserAgent,
 		subscriptionInfo.Username,
 		subscriptionInfo.Password,
+		subscriptionInfo.ProxyURL,
+		subscriptionInfo.Headers,
+		subscriptionInfo.Cookie,
 	)
 	if finderErr != nil {
 		json.ServerError(w, r, finderErr)
@@ -30,7 +30,12 @@ func startDaemon(store *storage.Storage) {
 	signal.Notify(stop, syscall.SIGTERM)
 
 	feedHandler := feed.NewFeedHandler(store)
-	pool := worker.NewPool(feedHandler, config.Opts.WorkerPoolSize())
+	pool := worker.NewPool(
+		feedHandler,
+		config.Opts.WorkerPoolSize(),
+		config.Opts.WorkerHostConcurrency(),
+		time.Duration(config.Opts.WorkerHostDelay())*time.Second,
+	)
 
 	go showProcessStatistics()
 
@@ -349,6 +349,23 @@ func (c *Client) FeedIcon(feedID int64) (*FeedIcon, error) {
 	return feedIcon, nil
 }
 
+// FeedHistory gets the refresh history of a feed.
+func (c *Client) FeedHistory(feedID int64) (FeedFetches, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/history", feedID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var fetches FeedFetches
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&fetches); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return fetches, nil
+}
+
 // FeedEntry gets a single feed entry.
 func (c *Client) FeedEntry(feedID, entryID int64) (*Entry, error) {
 	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/entries/%d", feedID, entryID))
@@ -383,6 +400,23 @@ func (c *Client) Entry(entryID int64) (*Entry, error) {
 	return entry, nil
 }
 
+// EntryRevisions gets the previous versions of an entry.
+func (c *Client) EntryRevisions(entryID int64) (EntryRevisions, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/revisions", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var revisions EntryRevisions
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&revisions); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return revisions, nil
+}
+
 // Entries fetch entries.
 func (c *Client) Entries(filter *Filter) (*EntryResultSet, error) {
 	path := buildFilterQueryString("/v1/entries", filter)
@@ -448,6 +482,302 @@ func (c *Client) ToggleBookmark(entryID int64) error {
 	return nil
 }
 
+// UpdateEntryTags replaces the tags of an entry.
+func (c *Client) UpdateEntryTags(entryID int64, tags []string) (Tags, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/tags", entryID), map[string]interface{}{
+		"tags": tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var entryTags Tags
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&entryTags); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return entryTags, nil
+}
+
+// Enclosure gets an attachment with its playback position.
+func (c *Client) Enclosure(enclosureID int64) (*Enclosure, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/enclosures/%d", enclosureID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var enclosure *Enclosure
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&enclosure); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return enclosure, nil
+}
+
+// UpdateEnclosure saves the playback position of an attachment, in seconds.
+func (c *Client) UpdateEnclosure(enclosureID int64, mediaProgression int) (*Enclosure, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/enclosures/%d", enclosureID), map[string]interface{}{
+		"media_progression": mediaProgression,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var enclosure *Enclosure
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&enclosure); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return enclosure, nil
+}
+
+// Tags gets the tags of the user with their number of entries.
+func (c *Client) Tags() (Tags, error) {
+	body, err := c.request.Get("/v1/tags")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var tags Tags
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&tags); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return tags, nil
+}
+
+// Rules gets the automation rules of the user.
+func (c *Client) Rules() (Rules, error) {
+	body, err := c.request.Get("/v1/rules")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rules Rules
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rules, nil
+}
+
+// Rule gets a single automation rule.
+func (c *Client) Rule(ruleID int64) (*Rule, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/rules/%d", ruleID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rule *Rule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rule, nil
+}
+
+// CreateRule creates a new automation rule.
+func (c *Client) CreateRule(rule *Rule) (*Rule, error) {
+	body, err := c.request.Post("/v1/rules", rule)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var createdRule *Rule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&createdRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return createdRule, nil
+}
+
+// UpdateRule replaces the conditions and the actions of an automation rule.
+func (c *Client) UpdateRule(ruleID int64, rule *Rule) (*Rule, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/rules/%d", ruleID), rule)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var updatedRule *Rule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&updatedRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return updatedRule, nil
+}
+
+// DeleteRule removes an automation rule.
+func (c *Client) DeleteRule(ruleID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/rules/%d", ruleID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return nil
+}
+
+// ApplyRule runs an automation rule on the existing entries and returns the number of entries matched.
+func (c *Client) ApplyRule(ruleID int64) (int, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/rules/%d/apply", ruleID), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	var result struct {
+		Matched int `json:"matched"`
+	}
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.Matched, nil
+}
+
+// SiteRules gets the global site rules and the site rules of the user.
+func (c *Client) SiteRules() (SiteRules, error) {
+	body, err := c.request.Get("/v1/site-rules")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rules SiteRules
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rules, nil
+}
+
+// SiteRule gets a single site rule.
+func (c *Client) SiteRule(siteRuleID int64) (*SiteRule, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/site-rules/%d", siteRuleID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rule *SiteRule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rule, nil
+}
+
+// CreateSiteRule creates a new site rule, only administrators can create global rules.
+func (c *Client) CreateSiteRule(rule *SiteRule) (*SiteRule, error) {
+	body, err := c.request.Post("/v1/site-rules", rule)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var createdRule *SiteRule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&createdRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return createdRule, nil
+}
+
+// UpdateSiteRule replaces the rules of a site rule.
+func (c *Client) UpdateSiteRule(siteRuleID int64, rule *SiteRule) (*SiteRule, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/site-rules/%d", siteRuleID), rule)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var updatedRule *SiteRule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&updatedRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return updatedRule, nil
+}
+
+// DeleteSiteRule removes a site rule.
+func (c *Client) DeleteSiteRule(siteRuleID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/site-rules/%d", siteRuleID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return nil
+}
+
+// ExportSiteRules returns the site rules of the user, or the global rules, without their identifiers.
+func (c *Client) ExportSiteRules(global bool) (SiteRules, error) {
+	path := "/v1/site-rules/export"
+	if global {
+		path += "?global=1"
+	}
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rules SiteRules
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rules, nil
+}
+
+// ImportSiteRules creates the site rules or replaces the existing rules of the same domains,
+// and returns the number of rules imported. Only administrators can import global rules.
+func (c *Client) ImportSiteRules(rules SiteRules, global bool) (int, error) {
+	path := "/v1/site-rules/import"
+	if global {
+		path += "?global=1"
+	}
+
+	body, err := c.request.Post(path, rules)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	var result struct {
+		Imported int `json:"imported"`
+	}
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.Imported, nil
+}
+
 // New returns a new Miniflux client.
 func New(endpoint, username, password string) *Client {
 	return &Client{request: &request{endpoint: endpoint, username: username, password: password}}
@@ -501,6 +831,18 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("search", filter.Search)
 		}
 
+		if filter.Tag != "" {
+			values.Set("tag", filter.Tag)
+		}
+
+		if filter.MinReadingTime > 0 {
+			values.Set("min_reading_time", strconv.Itoa(filter.MinReadingTime))
+		}
+
+		if filter.MaxReadingTime > 0 {
+			values.Set("max_reading_time", strconv.Itoa(filter.MaxReadingTime))
+		}
+
 		path = fmt.Sprintf("%s?%s", path, values.Encode())
 	}
 
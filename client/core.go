@@ -18,16 +18,19 @@ const (
 
 // User represents a user in the system.
 type User struct {
-	ID             int64             `json:"id"`
-	Username       string            `json:"username"`
-	Password       string            `json:"password,omitempty"`
-	IsAdmin        bool              `json:"is_admin"`
-	Theme          string            `json:"theme"`
-	Language       string            `json:"language"`
-	Timezone       string            `json:"timezone"`
-	EntryDirection string            `json:"entry_sorting_direction"`
-	LastLoginAt    *time.Time        `json:"last_login_at"`
-	Extra          map[string]string `json:"extra"`
+	ID                        int64             `json:"id"`
+	Username                  string            `json:"username"`
+	Password                  string            `json:"password,omitempty"`
+	IsAdmin                   bool              `json:"is_admin"`
+	Theme                     string            `json:"theme"`
+	Language                  string            `json:"language"`
+	Timezone                  string            `json:"timezone"`
+	EntryDirection            string            `json:"entry_sorting_direction"`
+	DuplicatePolicy           string            `json:"duplicate_policy"`
+	DuplicateMatchTitle       bool              `json:"duplicate_match_title"`
+	MarkReadOnMediaCompletion bool              `json:"mark_read_on_media_completion"`
+	LastLoginAt               *time.Time        `json:"last_login_at"`
+	Extra                     map[string]string `json:"extra"`
 }
 
 func (u User) String() string {
@@ -36,13 +39,16 @@ func (u User) String() string {
 
 // UserModification is used to update a user.
 type UserModification struct {
-	Username       *string `json:"username"`
-	Password       *string `json:"password"`
-	IsAdmin        *bool   `json:"is_admin"`
-	Theme          *string `json:"theme"`
-	Language       *string `json:"language"`
-	Timezone       *string `json:"timezone"`
-	EntryDirection *string `json:"entry_sorting_direction"`
+	Username                  *string `json:"username"`
+	Password                  *string `json:"password"`
+	IsAdmin                   *bool   `json:"is_admin"`
+	Theme                     *string `json:"theme"`
+	Language                  *string `json:"language"`
+	Timezone                  *string `json:"timezone"`
+	EntryDirection            *string `json:"entry_sorting_direction"`
+	DuplicatePolicy           *string `json:"duplicate_policy"`
+	DuplicateMatchTitle       *bool   `json:"duplicate_match_title"`
+	MarkReadOnMediaCompletion *bool   `json:"mark_read_on_media_completion"`
 }
 
 // Users represents a list of users.
@@ -78,38 +84,62 @@ type Subscriptions []*Subscription
 
 // Feed represents a Miniflux feed.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at,omitempty"`
-	EtagHeader         string    `json:"etag_header,omitempty"`
-	LastModifiedHeader string    `json:"last_modified_header,omitempty"`
-	ParsingErrorMsg    string    `json:"parsing_error_message,omitempty"`
-	ParsingErrorCount  int       `json:"parsing_error_count,omitempty"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Category           *Category `json:"category,omitempty"`
-	Entries            Entries   `json:"entries,omitempty"`
+	ID                     int64             `json:"id"`
+	UserID                 int64             `json:"user_id"`
+	FeedURL                string            `json:"feed_url"`
+	SiteURL                string            `json:"site_url"`
+	Title                  string            `json:"title"`
+	CheckedAt              time.Time         `json:"checked_at,omitempty"`
+	NextCheckAt            time.Time         `json:"next_check_at,omitempty"`
+	EtagHeader             string            `json:"etag_header,omitempty"`
+	LastModifiedHeader     string            `json:"last_modified_header,omitempty"`
+	ParsingErrorMsg        string            `json:"parsing_error_message,omitempty"`
+	ParsingErrorCount      int               `json:"parsing_error_count,omitempty"`
+	TTL                    int               `json:"ttl,omitempty"`
+	HubURL                 string            `json:"hub_url,omitempty"`
+	HubLeaseExpiresAt      time.Time         `json:"hub_lease_expires_at,omitempty"`
+	ScraperRules           string            `json:"scraper_rules"`
+	RewriteRules           string            `json:"rewrite_rules"`
+	KeepRules              string            `json:"keep_rules"`
+	BlockRules             string            `json:"block_rules"`
+	FilterAction           string            `json:"filter_action"`
+	Crawler                bool              `json:"crawler"`
+	MarkUnreadOnUpdate     bool              `json:"mark_unread_on_update"`
+	KeepTrackingParameters bool              `json:"keep_tracking_parameters"`
+	ResolveFinalURL        bool              `json:"resolve_final_url"`
+	Disabled               bool              `json:"disabled"`
+	UserAgent              string            `json:"user_agent"`
+	ProxyURL               string            `json:"proxy_url"`
+	Headers                map[string]string `json:"headers"`
+	Cookie                 string            `json:"cookie"`
+	Username               string            `json:"username"`
+	Password               string            `json:"password"`
+	Category               *Category         `json:"category,omitempty"`
+	Entries                Entries           `json:"entries,omitempty"`
 }
 
 // FeedModification represents changes for a feed.
 type FeedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
+	FeedURL                *string            `json:"feed_url"`
+	SiteURL                *string            `json:"site_url"`
+	Title                  *string            `json:"title"`
+	ScraperRules           *string            `json:"scraper_rules"`
+	RewriteRules           *string            `json:"rewrite_rules"`
+	KeepRules              *string            `json:"keep_rules"`
+	BlockRules             *string            `json:"block_rules"`
+	FilterAction           *string            `json:"filter_action"`
+	Crawler                *bool              `json:"crawler"`
+	MarkUnreadOnUpdate     *bool              `json:"mark_unread_on_update"`
+	KeepTrackingParameters *bool              `json:"keep_tracking_parameters"`
+	ResolveFinalURL        *bool              `json:"resolve_final_url"`
+	Disabled               *bool              `json:"disabled"`
+	UserAgent              *string            `json:"user_agent"`
+	ProxyURL               *string            `json:"proxy_url"`
+	Headers                *map[string]string `json:"headers"`
+	Cookie                 *string            `json:"cookie"`
+	Username               *string            `json:"username"`
+	Password               *string            `json:"password"`
+	CategoryID             *int64             `json:"category_id"`
 }
 
 // FeedIcon represents the feed icon.
@@ -119,56 +149,148 @@ type FeedIcon struct {
 	Data     string `json:"data"`
 }
 
+// FeedFetch represents one refresh of a feed.
+type FeedFetch struct {
+	ID             int64     `json:"id"`
+	FeedID         int64     `json:"feed_id"`
+	FetchedAt      time.Time `json:"fetched_at"`
+	StatusCode     int       `json:"status_code"`
+	Duration       int       `json:"duration"`
+	Size           int64     `json:"size"`
+	NotModified    bool      `json:"not_modified"`
+	CreatedEntries int       `json:"created_entries"`
+	UpdatedEntries int       `json:"updated_entries"`
+	Error          string    `json:"error"`
+	PreviousURL    string    `json:"previous_url"`
+	NewURL         string    `json:"new_url"`
+}
+
+// FeedFetches represents the refresh history of a feed.
+type FeedFetches []*FeedFetch
+
 // Feeds represents a list of feeds.
 type Feeds []*Feed
 
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID         int64      `json:"id"`
-	UserID     int64      `json:"user_id"`
-	FeedID     int64      `json:"feed_id"`
-	Status     string     `json:"status"`
-	Hash       string     `json:"hash"`
-	Title      string     `json:"title"`
-	URL        string     `json:"url"`
-	Date       time.Time  `json:"published_at"`
-	Content    string     `json:"content"`
-	Author     string     `json:"author"`
-	Starred    bool       `json:"starred"`
-	Enclosures Enclosures `json:"enclosures,omitempty"`
-	Feed       *Feed      `json:"feed,omitempty"`
-	Category   *Category  `json:"category,omitempty"`
+	ID           int64      `json:"id"`
+	UserID       int64      `json:"user_id"`
+	FeedID       int64      `json:"feed_id"`
+	Status       string     `json:"status"`
+	Hash         string     `json:"hash"`
+	Title        string     `json:"title"`
+	URL          string     `json:"url"`
+	Date         time.Time  `json:"published_at"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	Content      string     `json:"content"`
+	Author       string     `json:"author"`
+	ReadingTime  int        `json:"reading_time"`
+	ThumbnailURL string     `json:"thumbnail_url"`
+	Subtitle     string     `json:"subtitle"`
+	Episode      int        `json:"episode"`
+	Season       int        `json:"season"`
+	Explicit     bool       `json:"explicit"`
+	Starred      bool       `json:"starred"`
+	DuplicateOf  int64      `json:"duplicate_of_id,omitempty"`
+	Duplicates   Entries    `json:"duplicates,omitempty"`
+	Tags         Tags       `json:"tags"`
+	Enclosures   Enclosures `json:"enclosures,omitempty"`
+	Feed         *Feed      `json:"feed,omitempty"`
+	Category     *Category  `json:"category,omitempty"`
 }
 
 // Entries represents a list of entries.
 type Entries []*Entry
 
+// EntryRevision represents a previous version of an entry.
+type EntryRevision struct {
+	ID        int64     `json:"id"`
+	EntryID   int64     `json:"entry_id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EntryRevisions represents the list of previous versions of an entry.
+type EntryRevisions []*EntryRevision
+
+// Tag represents a label attached to entries.
+type Tag struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	Title      string `json:"title"`
+	EntryCount int    `json:"entry_count,omitempty"`
+}
+
+// Tags represents a list of tags.
+type Tags []*Tag
+
 // Enclosure represents an attachment.
 type Enclosure struct {
-	ID       int64  `json:"id"`
-	UserID   int64  `json:"user_id"`
-	EntryID  int64  `json:"entry_id"`
-	URL      string `json:"url"`
-	MimeType string `json:"mime_type"`
-	Size     int    `json:"size"`
+	ID               int64  `json:"id"`
+	UserID           int64  `json:"user_id"`
+	EntryID          int64  `json:"entry_id"`
+	URL              string `json:"url"`
+	MimeType         string `json:"mime_type"`
+	Size             int    `json:"size"`
+	Duration         int    `json:"duration"`
+	MediaProgression int    `json:"media_progression"`
 }
 
 // Enclosures represents a list of attachments.
 type Enclosures []*Enclosure
 
+// Rule represents an automation rule applied to the entries.
+type Rule struct {
+	ID                 int64  `json:"id,omitempty"`
+	UserID             int64  `json:"user_id,omitempty"`
+	Title              string `json:"title"`
+	FeedID             int64  `json:"feed_id"`
+	CategoryID         int64  `json:"category_id"`
+	TitlePattern       string `json:"title_pattern"`
+	ContentPattern     string `json:"content_pattern"`
+	AuthorPattern      string `json:"author_pattern"`
+	OlderThan          int    `json:"older_than"`
+	MarkAsRead         bool   `json:"mark_as_read"`
+	Star               bool   `json:"star"`
+	SendToIntegrations bool   `json:"send_to_integrations"`
+	Tag                string `json:"tag"`
+	MatchCount         int    `json:"match_count,omitempty"`
+}
+
+// Rules represents a list of automation rules.
+type Rules []*Rule
+
+// SiteRule represents the scraper and rewrite rules used by default for the entries of a website.
+type SiteRule struct {
+	ID           int64  `json:"id,omitempty"`
+	UserID       int64  `json:"user_id,omitempty"`
+	Global       bool   `json:"global"`
+	Domain       string `json:"domain"`
+	ScraperRules string `json:"scraper_rules"`
+	RewriteRules string `json:"rewrite_rules"`
+	Disabled     bool   `json:"disabled"`
+}
+
+// SiteRules represents a list of site rules.
+type SiteRules []*SiteRule
+
 // Filter is used to filter entries.
 type Filter struct {
-	Status        string
-	Offset        int
-	Limit         int
-	Order         string
-	Direction     string
-	Starred       bool
-	Before        int64
-	After         int64
-	BeforeEntryID int64
-	AfterEntryID  int64
-	Search        string
+	Status         string
+	Offset         int
+	Limit          int
+	Order          string
+	Direction      string
+	Starred        bool
+	Before         int64
+	After          int64
+	BeforeEntryID  int64
+	AfterEntryID   int64
+	Search         string
+	Tag            string
+	MinReadingTime int
+	MaxReadingTime int
 }
 
 // EntryResultSet represents the response when fetching entries.
@@ -7,6 +7,7 @@ package config // import "miniflux.app/config"
 import (
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -514,6 +515,76 @@ func TestWorkerPoolSize(t *testing.T) {
 	}
 }
 
+func TestDefaultWorkerHostConcurrencyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultWorkerHostConcurrency
+	result := opts.WorkerHostConcurrency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WORKER_HOST_CONCURRENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestWorkerHostConcurrency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WORKER_HOST_CONCURRENCY", "4")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 4
+	result := opts.WorkerHostConcurrency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WORKER_HOST_CONCURRENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultWorkerHostDelayValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultWorkerHostDelay
+	result := opts.WorkerHostDelay()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WORKER_HOST_DELAY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestWorkerHostDelay(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WORKER_HOST_DELAY", "10")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 10
+	result := opts.WorkerHostDelay()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WORKER_HOST_DELAY value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestDefautPollingFrequencyValue(t *testing.T) {
 	os.Clearenv()
 
@@ -584,6 +655,76 @@ func TestBatchSize(t *testing.T) {
 	}
 }
 
+func TestDefaultSchedulerMinIntervalValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSchedulerMinInterval
+	result := opts.SchedulerMinInterval()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCHEDULER_MIN_INTERVAL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSchedulerMinInterval(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCHEDULER_MIN_INTERVAL", "42")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 42
+	result := opts.SchedulerMinInterval()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCHEDULER_MIN_INTERVAL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSchedulerMaxIntervalValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSchedulerMaxInterval
+	result := opts.SchedulerMaxInterval()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCHEDULER_MAX_INTERVAL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSchedulerMaxInterval(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCHEDULER_MAX_INTERVAL", "42")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 42
+	result := opts.SchedulerMaxInterval()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCHEDULER_MAX_INTERVAL value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestOAuth2UserCreationWhenUnset(t *testing.T) {
 	os.Clearenv()
 
@@ -952,6 +1093,41 @@ func TestCreateAdmin(t *testing.T) {
 	}
 }
 
+func TestWebSubWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := false
+	result := opts.HasWebSub()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENABLE_WEBSUB value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestWebSub(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENABLE_WEBSUB", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := true
+	result := opts.HasWebSub()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENABLE_WEBSUB value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestPocketConsumerKeyFromEnvVariable(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("POCKET_CONSUMER_KEY", "something")
@@ -1121,6 +1297,256 @@ func TestDefaultHTTPClientMaxBodySizeValue(t *testing.T) {
 	}
 }
 
+func TestHTTPClientProxy(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_PROXY", "socks5://127.0.0.1:9050")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "socks5://127.0.0.1:9050"
+	result := opts.HTTPClientProxy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_PROXY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultHTTPClientProxyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultHTTPClientProxy
+	result := opts.HTTPClientProxy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_PROXY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestEncryptionKey(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "secret")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "secret"
+	result := opts.EncryptionKey()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENCRYPTION_KEY value, got %q instead of %q`, result, expected)
+	}
+
+	if !opts.HasEncryptionKey() {
+		t.Fatal(`The encryption key should be defined`)
+	}
+
+	if strings.Contains(opts.String(), "secret") || !strings.Contains(opts.String(), "ENCRYPTION_KEY: ****\n") {
+		t.Fatal(`The encryption key should be masked`)
+	}
+}
+
+func TestDefaultEncryptionKeyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasEncryptionKey() {
+		t.Fatal(`The encryption key should not be defined by default`)
+	}
+}
+
+func TestFeedFetchLogSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("FEED_FETCH_LOG_SIZE", "10")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 10
+	result := opts.FeedFetchLogSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FEED_FETCH_LOG_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultFeedFetchLogSizeValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultFeedFetchLogSize
+	result := opts.FeedFetchLogSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FEED_FETCH_LOG_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCrawlerConcurrency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CRAWLER_CONCURRENCY", "8")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 8
+	result := opts.CrawlerConcurrency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CRAWLER_CONCURRENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultCrawlerConcurrencyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultCrawlerConcurrency
+	result := opts.CrawlerConcurrency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CRAWLER_CONCURRENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCrawlerTimeout(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CRAWLER_TIMEOUT", "120")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 120
+	result := opts.CrawlerTimeout()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CRAWLER_TIMEOUT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultCrawlerTimeoutValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultCrawlerTimeout
+	result := opts.CrawlerTimeout()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CRAWLER_TIMEOUT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestEntryRevisionsLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENTRY_REVISIONS_LIMIT", "3")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 3
+	result := opts.EntryRevisionsLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENTRY_REVISIONS_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultEntryRevisionsLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultEntryRevisionsLimit
+	result := opts.EntryRevisionsLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENTRY_REVISIONS_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestTrackingParameters(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRACKING_PARAMETERS", "utm_*, fbclid,,ref ")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "utm_*|fbclid|ref"
+	result := strings.Join(opts.TrackingParameters(), "|")
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRACKING_PARAMETERS value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultTrackingParametersValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := strings.Replace(defaultTrackingParameters, ",", "|", -1)
+	result := strings.Join(opts.TrackingParameters(), "|")
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRACKING_PARAMETERS value, got %q instead of %q`, result, expected)
+	}
+}
+
 func TestParseConfigFile(t *testing.T) {
 	content := []byte(`
  # This is a comment
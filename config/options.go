@@ -20,7 +20,11 @@ const (
 	defaultRootURL               = "http://localhost"
 	defaultBasePath              = ""
 	defaultWorkerPoolSize        = 5
+	defaultWorkerHostConcurrency = 2
+	defaultWorkerHostDelay       = 1
 	defaultPollingFrequency      = 60
+	defaultSchedulerMinInterval  = 5
+	defaultSchedulerMaxInterval  = 1440
 	defaultBatchSize             = 10
 	defaultRunMigrations         = false
 	defaultDatabaseURL           = "user=postgres password=postgres dbname=miniflux2 sslmode=disable"
@@ -35,6 +39,7 @@ const (
 	defaultCleanupFrequency      = 24
 	defaultProxyImages           = "http-only"
 	defaultCreateAdmin           = false
+	defaultWebSub                = false
 	defaultOAuth2UserCreation    = false
 	defaultOAuth2ClientID        = ""
 	defaultOAuth2ClientSecret    = ""
@@ -43,6 +48,13 @@ const (
 	defaultPocketConsumerKey     = ""
 	defaultHTTPClientTimeout     = 20
 	defaultHTTPClientMaxBodySize = 15
+	defaultHTTPClientProxy       = ""
+	defaultEncryptionKey         = ""
+	defaultFeedFetchLogSize      = 50
+	defaultCrawlerConcurrency    = 4
+	defaultCrawlerTimeout        = 60
+	defaultEntryRevisionsLimit   = 10
+	defaultTrackingParameters    = "utm_*,fbclid,gclid,dclid,msclkid,yclid,igshid,mc_cid,mc_eid,_hsenc,_hsmi,mkt_tok"
 )
 
 // Options contains configuration options.
@@ -68,9 +80,14 @@ type Options struct {
 	cleanupFrequency          int
 	archiveReadDays           int
 	pollingFrequency          int
+	schedulerMinInterval      int
+	schedulerMaxInterval      int
 	batchSize                 int
 	workerPoolSize            int
+	workerHostConcurrency     int
+	workerHostDelay           int
 	createAdmin               bool
+	webSub                    bool
 	proxyImages               string
 	oauth2UserCreationAllowed bool
 	oauth2ClientID            string
@@ -80,6 +97,13 @@ type Options struct {
 	pocketConsumerKey         string
 	httpClientTimeout         int
 	httpClientMaxBodySize     int64
+	httpClientProxy           string
+	encryptionKey             string
+	feedFetchLogSize          int
+	crawlerConcurrency        int
+	crawlerTimeout            int
+	entryRevisionsLimit       int
+	trackingParameters        []string
 }
 
 // NewOptions returns Options with default values.
@@ -106,9 +130,14 @@ func NewOptions() *Options {
 		cleanupFrequency:          defaultCleanupFrequency,
 		archiveReadDays:           defaultArchiveReadDays,
 		pollingFrequency:          defaultPollingFrequency,
+		schedulerMinInterval:      defaultSchedulerMinInterval,
+		schedulerMaxInterval:      defaultSchedulerMaxInterval,
 		batchSize:                 defaultBatchSize,
 		workerPoolSize:            defaultWorkerPoolSize,
+		workerHostConcurrency:     defaultWorkerHostConcurrency,
+		workerHostDelay:           defaultWorkerHostDelay,
 		createAdmin:               defaultCreateAdmin,
+		webSub:                    defaultWebSub,
 		proxyImages:               defaultProxyImages,
 		oauth2UserCreationAllowed: defaultOAuth2UserCreation,
 		oauth2ClientID:            defaultOAuth2ClientID,
@@ -118,6 +147,13 @@ func NewOptions() *Options {
 		pocketConsumerKey:         defaultPocketConsumerKey,
 		httpClientTimeout:         defaultHTTPClientTimeout,
 		httpClientMaxBodySize:     defaultHTTPClientMaxBodySize * 1024 * 1024,
+		httpClientProxy:           defaultHTTPClientProxy,
+		encryptionKey:             defaultEncryptionKey,
+		feedFetchLogSize:          defaultFeedFetchLogSize,
+		crawlerConcurrency:        defaultCrawlerConcurrency,
+		crawlerTimeout:            defaultCrawlerTimeout,
+		entryRevisionsLimit:       defaultEntryRevisionsLimit,
+		trackingParameters:        parseStringList(defaultTrackingParameters, nil),
 	}
 }
 
@@ -201,11 +237,31 @@ func (o *Options) WorkerPoolSize() int {
 	return o.workerPoolSize
 }
 
-// PollingFrequency returns the interval to refresh feeds in the background.
+// WorkerHostConcurrency returns the maximum number of feeds refreshed at the same time on the same host.
+func (o *Options) WorkerHostConcurrency() int {
+	return o.workerHostConcurrency
+}
+
+// WorkerHostDelay returns the minimum number of seconds between two requests to the same host.
+func (o *Options) WorkerHostDelay() int {
+	return o.workerHostDelay
+}
+
+// PollingFrequency returns the interval to look for feeds to refresh in the background.
 func (o *Options) PollingFrequency() int {
 	return o.pollingFrequency
 }
 
+// SchedulerMinInterval returns the minimum number of minutes between two refreshes of the same feed.
+func (o *Options) SchedulerMinInterval() int {
+	return o.schedulerMinInterval
+}
+
+// SchedulerMaxInterval returns the maximum number of minutes between two refreshes of the same feed.
+func (o *Options) SchedulerMaxInterval() int {
+	return o.schedulerMaxInterval
+}
+
 // BatchSize returns the number of feeds to send for background processing.
 func (o *Options) BatchSize() int {
 	return o.batchSize
@@ -251,6 +307,11 @@ func (o *Options) CreateAdmin() bool {
 	return o.createAdmin
 }
 
+// HasWebSub returns true if feeds should subscribe to their WebSub hub to receive updates.
+func (o *Options) HasWebSub() bool {
+	return o.webSub
+}
+
 // ProxyImages returns "none" to never proxy, "http-only" to proxy non-HTTPS, "all" to always proxy.
 func (o *Options) ProxyImages() string {
 	return o.proxyImages
@@ -289,6 +350,46 @@ func (o *Options) HTTPClientMaxBodySize() int64 {
 	return o.httpClientMaxBodySize
 }
 
+// HTTPClientProxy returns the proxy URL used for outgoing requests when the feed does not define one.
+func (o *Options) HTTPClientProxy() string {
+	return o.httpClientProxy
+}
+
+// EncryptionKey returns the secret used to encrypt sensitive feed settings in the database.
+func (o *Options) EncryptionKey() string {
+	return o.encryptionKey
+}
+
+// HasEncryptionKey returns true if an encryption key is configured.
+func (o *Options) HasEncryptionKey() bool {
+	return o.encryptionKey != ""
+}
+
+// FeedFetchLogSize returns the number of refreshes kept in the history of each feed.
+func (o *Options) FeedFetchLogSize() int {
+	return o.feedFetchLogSize
+}
+
+// CrawlerConcurrency returns the maximum number of entries crawled at the same time for a feed.
+func (o *Options) CrawlerConcurrency() int {
+	return o.crawlerConcurrency
+}
+
+// CrawlerTimeout returns the time limit in seconds to crawl the new entries of a feed.
+func (o *Options) CrawlerTimeout() int {
+	return o.crawlerTimeout
+}
+
+// EntryRevisionsLimit returns the number of previous versions kept for each entry.
+func (o *Options) EntryRevisionsLimit() int {
+	return o.entryRevisionsLimit
+}
+
+// TrackingParameters returns the query parameters removed from the links of the entries.
+func (o *Options) TrackingParameters() []string {
+	return o.trackingParameters
+}
+
 func (o *Options) String() string {
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("LOG_DATE_TIME: %v\n", o.logDateTime))
@@ -311,11 +412,16 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("CERT_CACHE: %v\n", o.certCache))
 	builder.WriteString(fmt.Sprintf("CLEANUP_FREQUENCY: %v\n", o.cleanupFrequency))
 	builder.WriteString(fmt.Sprintf("WORKER_POOL_SIZE: %v\n", o.workerPoolSize))
+	builder.WriteString(fmt.Sprintf("WORKER_HOST_CONCURRENCY: %v\n", o.workerHostConcurrency))
+	builder.WriteString(fmt.Sprintf("WORKER_HOST_DELAY: %v\n", o.workerHostDelay))
 	builder.WriteString(fmt.Sprintf("POLLING_FREQUENCY: %v\n", o.pollingFrequency))
+	builder.WriteString(fmt.Sprintf("SCHEDULER_MIN_INTERVAL: %v\n", o.schedulerMinInterval))
+	builder.WriteString(fmt.Sprintf("SCHEDULER_MAX_INTERVAL: %v\n", o.schedulerMaxInterval))
 	builder.WriteString(fmt.Sprintf("BATCH_SIZE: %v\n", o.batchSize))
 	builder.WriteString(fmt.Sprintf("ARCHIVE_READ_DAYS: %v\n", o.archiveReadDays))
 	builder.WriteString(fmt.Sprintf("PROXY_IMAGES: %v\n", o.proxyImages))
 	builder.WriteString(fmt.Sprintf("CREATE_ADMIN: %v\n", o.createAdmin))
+	builder.WriteString(fmt.Sprintf("ENABLE_WEBSUB: %v\n", o.webSub))
 	builder.WriteString(fmt.Sprintf("POCKET_CONSUMER_KEY: %v\n", o.pocketConsumerKey))
 	builder.WriteString(fmt.Sprintf("OAUTH2_USER_CREATION: %v\n", o.oauth2UserCreationAllowed))
 	builder.WriteString(fmt.Sprintf("OAUTH2_CLIENT_ID: %v\n", o.oauth2ClientID))
@@ -324,5 +430,20 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("OAUTH2_PROVIDER: %v\n", o.oauth2Provider))
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_TIMEOUT: %v\n", o.httpClientTimeout))
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_MAX_BODY_SIZE: %v\n", o.httpClientMaxBodySize))
+	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_PROXY: %v\n", o.httpClientProxy))
+	builder.WriteString(fmt.Sprintf("ENCRYPTION_KEY: %v\n", maskSecret(o.encryptionKey)))
+	builder.WriteString(fmt.Sprintf("FEED_FETCH_LOG_SIZE: %v\n", o.feedFetchLogSize))
+	builder.WriteString(fmt.Sprintf("CRAWLER_CONCURRENCY: %v\n", o.crawlerConcurrency))
+	builder.WriteString(fmt.Sprintf("CRAWLER_TIMEOUT: %v\n", o.crawlerTimeout))
+	builder.WriteString(fmt.Sprintf("ENTRY_REVISIONS_LIMIT: %v\n", o.entryRevisionsLimit))
+	builder.WriteString(fmt.Sprintf("TRACKING_PARAMETERS: %v\n", strings.Join(o.trackingParameters, ",")))
 	return builder.String()
 }
+
+// maskSecret hides the value of a secret, only its presence is displayed.
+func maskSecret(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "****"
+}
@@ -112,8 +112,16 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.cleanupFrequency = parseInt(value, defaultCleanupFrequency)
 		case "WORKER_POOL_SIZE":
 			p.opts.workerPoolSize = parseInt(value, defaultWorkerPoolSize)
+		case "WORKER_HOST_CONCURRENCY":
+			p.opts.workerHostConcurrency = parseInt(value, defaultWorkerHostConcurrency)
+		case "WORKER_HOST_DELAY":
+			p.opts.workerHostDelay = parseInt(value, defaultWorkerHostDelay)
 		case "POLLING_FREQUENCY":
 			p.opts.pollingFrequency = parseInt(value, defaultPollingFrequency)
+		case "SCHEDULER_MIN_INTERVAL":
+			p.opts.schedulerMinInterval = parseInt(value, defaultSchedulerMinInterval)
+		case "SCHEDULER_MAX_INTERVAL":
+			p.opts.schedulerMaxInterval = parseInt(value, defaultSchedulerMaxInterval)
 		case "BATCH_SIZE":
 			p.opts.batchSize = parseInt(value, defaultBatchSize)
 		case "ARCHIVE_READ_DAYS":
@@ -122,6 +130,8 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.proxyImages = parseString(value, defaultProxyImages)
 		case "CREATE_ADMIN":
 			p.opts.createAdmin = parseBool(value, defaultCreateAdmin)
+		case "ENABLE_WEBSUB":
+			p.opts.webSub = parseBool(value, defaultWebSub)
 		case "POCKET_CONSUMER_KEY":
 			p.opts.pocketConsumerKey = parseString(value, defaultPocketConsumerKey)
 		case "OAUTH2_USER_CREATION":
@@ -138,6 +148,20 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.httpClientTimeout = parseInt(value, defaultHTTPClientTimeout)
 		case "HTTP_CLIENT_MAX_BODY_SIZE":
 			p.opts.httpClientMaxBodySize = int64(parseInt(value, defaultHTTPClientMaxBodySize) * 1024 * 1024)
+		case "HTTP_CLIENT_PROXY":
+			p.opts.httpClientProxy = parseString(value, defaultHTTPClientProxy)
+		case "ENCRYPTION_KEY":
+			p.opts.encryptionKey = parseString(value, defaultEncryptionKey)
+		case "FEED_FETCH_LOG_SIZE":
+			p.opts.feedFetchLogSize = parseInt(value, defaultFeedFetchLogSize)
+		case "CRAWLER_CONCURRENCY":
+			p.opts.crawlerConcurrency = parseInt(value, defaultCrawlerConcurrency)
+		case "CRAWLER_TIMEOUT":
+			p.opts.crawlerTimeout = parseInt(value, defaultCrawlerTimeout)
+		case "ENTRY_REVISIONS_LIMIT":
+			p.opts.entryRevisionsLimit = parseInt(value, defaultEntryRevisionsLimit)
+		case "TRACKING_PARAMETERS":
+			p.opts.trackingParameters = parseStringList(value, parseStringList(defaultTrackingParameters, nil))
 		}
 	}
 
@@ -203,3 +227,17 @@ func parseString(value string, fallback string) string {
 	}
 	return value
 }
+
+func parseStringList(value string, fallback []string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	if len(list) == 0 {
+		return fallback
+	}
+	return list
+}
@@ -5,9 +5,12 @@
 package crypto // import "miniflux.app/crypto"
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 )
 
@@ -36,3 +39,53 @@ func GenerateRandomBytes(size int) []byte {
 func GenerateRandomString(size int) string {
 	return base64.URLEncoding.EncodeToString(GenerateRandomBytes(size))
 }
+
+// Encrypt encrypts a value with AES-256-GCM and returns it encoded in base64.
+// The encryption key is derived from the given secret.
+func Encrypt(secret, value string) (string, error) {
+	aead, err := newCipher(secret)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := GenerateRandomBytes(aead.NonceSize())
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(value), nil)), nil
+}
+
+// Decrypt decrypts a value returned by Encrypt.
+func Decrypt(secret, value string) (string, error) {
+	aead, err := newCipher(secret)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("crypto: unable to decode encrypted value: %v", err)
+	}
+
+	if len(data) < aead.NonceSize() {
+		return "", errors.New("crypto: encrypted value too short")
+	}
+
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("crypto: unable to decrypt value: %v", err)
+	}
+
+	return string(plaintext), nil
+}
+
+func newCipher(secret string) (cipher.AEAD, error) {
+	if secret == "" {
+		return nil, errors.New("crypto: the encryption key is empty")
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,53 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package crypto // import "miniflux.app/crypto"
+
+import "testing"
+
+func TestEncryptDecrypt(t *testing.T) {
+	encrypted, err := Encrypt("secret", "Bearer token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if encrypted == "Bearer token" {
+		t.Fatal(`The value should be encrypted`)
+	}
+
+	decrypted, err := Decrypt("secret", encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decrypted != "Bearer token" {
+		t.Errorf(`Unexpected decrypted value, got %q`, decrypted)
+	}
+}
+
+func TestEncryptUsesRandomNonce(t *testing.T) {
+	first, _ := Encrypt("secret", "value")
+	second, _ := Encrypt("secret", "value")
+
+	if first == second {
+		t.Error(`Encrypting the same value twice should not give the same result`)
+	}
+}
+
+func TestDecryptWithWrongKey(t *testing.T) {
+	encrypted, err := Encrypt("secret", "value")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Decrypt("other secret", encrypted); err == nil {
+		t.Error(`Decrypting with another key should fail`)
+	}
+}
+
+func TestEncryptWithoutKey(t *testing.T) {
+	if _, err := Encrypt("", "value"); err == nil {
+		t.Error(`Encrypting without key should fail`)
+	}
+}
@@ -147,14 +147,168 @@ create index document_vectors_idx on entries using gin(document_vectors);`,
 	"schema_version_21": `alter table feeds add column user_agent text default '';`,
 	"schema_version_22": `update entries set document_vectors = setweight(to_tsvector(substring(coalesce(title, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce(content, '') for 1000000)), 'B');`,
 	"schema_version_23": `alter table users add column keyboard_shortcuts boolean default 't';`,
+	"schema_version_24": `alter table feeds add column next_check_at timestamp with time zone default now();
+alter table feeds add column ttl int default 0;
+create index feeds_next_check_at_idx on feeds(next_check_at);
+`,
+	"schema_version_25": `alter table feeds add column disabled bool default 'f';
+`,
+	"schema_version_26": `alter table feeds add column hub_url text default '';
+alter table feeds add column hub_topic_url text default '';
+alter table feeds add column hub_secret text default '';
+alter table feeds add column hub_lease_expires_at timestamp with time zone default 'epoch';
+alter table feeds add column hub_pending_mode text not null default '';
+alter table feeds add column hub_pending_expires_at timestamp with time zone null;
+`,
+	"schema_version_27": `alter table feeds add column proxy_url text default '';
+`,
+	"schema_version_28": `alter table feeds add column headers text default '';
+alter table feeds add column cookie text default '';
+`,
+	"schema_version_29": `create table feed_fetch_log (
+    id bigserial not null,
+    feed_id bigint not null,
+    fetched_at timestamp with time zone not null default now(),
+    status_code int not null default 0,
+    duration int not null default 0,
+    size bigint not null default 0,
+    not_modified bool not null default 'f',
+    created_entries int not null default 0,
+    updated_entries int not null default 0,
+    error_msg text not null default '',
+    previous_url text not null default '',
+    new_url text not null default '',
+    primary key (id),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+create index feed_fetch_log_feed_idx on feed_fetch_log using btree(feed_id, fetched_at);
+`,
 	"schema_version_3": `create table tokens (
     id text not null,
     value text not null,
     created_at timestamp with time zone not null default now(),
     primary key(id, value)
 );`,
+	"schema_version_30": `alter table entries add column crawl_pending bool default 'f';
+`,
+	"schema_version_31": `alter table users add column duplicate_policy text default 'none';
+alter table users add column duplicate_match_title bool default 'f';
+alter table entries add column duplicate_of bigint null references entries(id) on delete set null;
+alter table entries add column grouped bool not null default 'f';
+alter table entries add column canonical_url text;
+alter table entries add column normalized_title text;
+create index entries_duplicate_of_idx on entries(duplicate_of);
+create index entries_user_canonical_url_idx on entries(user_id, canonical_url);
+create index entries_user_normalized_title_idx on entries(user_id, normalized_title);
+`,
+	"schema_version_32": `create table entry_revisions (
+    id bigserial not null,
+    entry_id bigint not null,
+    title text not null default '',
+    content text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+create index entry_revisions_entry_idx on entry_revisions(entry_id, created_at);
+alter table entries add column updated_at timestamp with time zone;
+alter table feeds add column mark_unread_on_update bool default 'f';
+`,
+	"schema_version_33": `alter table feeds add column keep_rules text default '';
+alter table feeds add column block_rules text default '';
+alter table feeds add column filter_action text default 'drop';
+`,
+	"schema_version_34": `create table rules (
+    id serial not null,
+    user_id int not null,
+    title text not null,
+    feed_id bigint,
+    category_id int,
+    title_pattern text not null default '',
+    content_pattern text not null default '',
+    author_pattern text not null default '',
+    older_than int not null default 0,
+    mark_as_read bool not null default 'f',
+    star bool not null default 'f',
+    send_to_integrations bool not null default 'f',
+    tag text not null default '',
+    match_count bigint not null default 0,
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade
+);
+
+create index rules_user_idx on rules(user_id);
+`,
+	"schema_version_35": `create table tags (
+    id serial not null,
+    user_id int not null,
+    title text not null,
+    primary key (id),
+    unique (user_id, title),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table entry_tags (
+    entry_id bigint not null,
+    tag_id int not null,
+    primary key (entry_id, tag_id),
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (tag_id) references tags(id) on delete cascade
+);
+
+create index entry_tags_tag_idx on entry_tags(tag_id);
+`,
+	"schema_version_36": `-- The reading time of the existing entries is estimated as in reader/readingtime: 265 words or 500 CJK characters per minute.
+alter table entries add column reading_time int not null default 0;
+
+update entries set reading_time = ceil(
+    (select count(*) from regexp_matches(regexp_replace(regexp_replace(coalesce(content, ''), '<[^>]*>', ' ', 'g'), '[\u3040-\u30ff\u3400-\u9fff\uac00-\ud7af]', ' ', 'g'), '[[:alnum:]]+', 'g')) / 265.0 +
+    char_length(regexp_replace(regexp_replace(coalesce(content, ''), '<[^>]*>', ' ', 'g'), '[^\u3040-\u30ff\u3400-\u9fff\uac00-\ud7af]', '', 'g')) / 500.0
+);
+`,
+	"schema_version_37": `create table site_rules (
+    id serial not null,
+    user_id int,
+    domain text not null,
+    scraper_rules text not null default '',
+    rewrite_rules text not null default '',
+    disabled bool not null default 'f',
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create unique index site_rules_global_domain_idx on site_rules(domain) where user_id is null;
+create unique index site_rules_user_domain_idx on site_rules(user_id, domain) where user_id is not null;
+`,
+	"schema_version_38": `alter table feeds add column keep_tracking_parameters bool default 'f';
+`,
+	"schema_version_39": `alter table feeds add column resolve_final_url bool default 'f';
+create table resolved_urls (
+    user_id bigint not null,
+    url text not null,
+    final_url text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id, url),
+    foreign key (user_id) references users(id) on delete cascade
+);
+create index resolved_urls_created_at_idx on resolved_urls(created_at);
+`,
 	"schema_version_4": `create type entry_sorting_direction as enum('asc', 'desc');
 alter table users add column entry_direction entry_sorting_direction default 'asc';
+`,
+	"schema_version_40": `alter table entries add column thumbnail_url text not null default '';
+`,
+	"schema_version_41": `alter table entries add column subtitle text not null default '';
+alter table entries add column episode int not null default 0;
+alter table entries add column season int not null default 0;
+alter table entries add column explicit bool not null default 'f';
+alter table enclosures add column duration int not null default 0;
+`,
+	"schema_version_42": `alter table enclosures add column media_progression int not null default 0;
+alter table users add column mark_read_on_media_completion bool default 'f';
 `,
 	"schema_version_5": `create table integrations (
     user_id int not null,
@@ -198,8 +352,27 @@ var SqlMapChecksums = map[string]string{
 	"schema_version_21": "77da01ee38918ff4fe33985fbb20ed3276a717a7584c2ca9ebcf4d4ab6cb6910",
 	"schema_version_22": "51ed5fbcae9877e57274511f0ef8c61d254ebd78dfbcbc043a2acd30f4c93ca3",
 	"schema_version_23": "cb3512d328436447f114e305048c0daa8af7505cfe5eab02778b0de1156081b2",
+	"schema_version_24": "8a82d06eafc045d3ceb2ee799500e41a7e148766107ec0898a4bacad0d1756d0",
+	"schema_version_25": "367bf3e81d918f54695d3c622ad1a614d0886a6c7a62ab3f237497cc8557c341",
+	"schema_version_26": "b44dbccf6e03cdea0e84df0329de13c9995271d5d41ecca590b2cf79bcd3607b",
+	"schema_version_27": "278c196ee61d2eb808cd0aff1eb3a31a39181dc77f2f224aeb1ae8b412f63013",
+	"schema_version_28": "00860e145048db968004d3ecad350e948244a2f81b061e7bb3f1c49583e3a1a0",
+	"schema_version_29": "ac284d49ca33fc11805d8bd33737ca9b3588fb172c21c80b9d83047829213c0b",
 	"schema_version_3":  "a54745dbc1c51c000f74d4e5068f1e2f43e83309f023415b1749a47d5c1e0f12",
+	"schema_version_30": "dece5653d62e83aafeb5bc4876280c041c9a8761720d848267143ab9d78c2bc8",
+	"schema_version_31": "a45b28524d49c4f0a1f1af20bf3b0bd1fbf918b63ba344a0b7535b5dff9c9c19",
+	"schema_version_32": "26a1710486f31c3beebfd46e1e492f4a5b165ddef7cf864476762d4be7b7c0a2",
+	"schema_version_33": "c99d6abd0f600b4c58a853249a0b5e6f8c70ca8498238d825ad61cb22ad9b069",
+	"schema_version_34": "2fffa480a076b7164e11e7d23831b8a45bbe259ce3fa4a825bd32daf8297ab56",
+	"schema_version_35": "6bb155b9e144c3d826559dec56d046d4eaa2cef9d2d64e66ed18b9fc040b9eab",
+	"schema_version_36": "f3b021ef9ee72232f3fc52ab632a13f75f0548d745a3bc3e3d3bc1810b3d5b1d",
+	"schema_version_37": "f58e537f6589b6391c013e0fb956f1db7fed46d8185e93122e9fe867d71f76ae",
+	"schema_version_38": "b1a1f22189643d86f7ba0a139627c62220bc99c480ab71547c8aa7ad65be029a",
+	"schema_version_39": "a830de7887830d6bcd16bc02535b8f814401cce38873b2c2c096f53df53741b9",
 	"schema_version_4":  "216ea3a7d3e1704e40c797b5dc47456517c27dbb6ca98bf88812f4f63d74b5d9",
+	"schema_version_40": "5a66e417a4df2f79c76eb0abc2b6258b41b5831b5466ce21401e96fb8b5e96f3",
+	"schema_version_41": "8eed67441cbe3f943f20205ed5db5c3593e8eadc8a2bc1e7b728137a2406951c",
+	"schema_version_42": "e25206fac8d1cd547e24f0fb458291f4bbaa3babec2a38ad4c326a4dfaf386a9",
 	"schema_version_5":  "46397e2f5f2c82116786127e9f6a403e975b14d2ca7b652a48cd1ba843e6a27c",
 	"schema_version_6":  "9d05b4fb223f0e60efc716add5048b0ca9c37511cf2041721e20505d6d798ce4",
 	"schema_version_7":  "33f298c9aa30d6de3ca28e1270df51c2884d7596f1283a75716e2aeb634cd05c",
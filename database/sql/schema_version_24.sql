@@ -0,0 +1,3 @@
+alter table feeds add column next_check_at timestamp with time zone default now();
+alter table feeds add column ttl int default 0;
+create index feeds_next_check_at_idx on feeds(next_check_at);
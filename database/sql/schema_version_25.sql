@@ -0,0 +1 @@
+alter table feeds add column disabled bool default 'f';
@@ -0,0 +1,6 @@
+alter table feeds add column hub_url text default '';
+alter table feeds add column hub_topic_url text default '';
+alter table feeds add column hub_secret text default '';
+alter table feeds add column hub_lease_expires_at timestamp with time zone default 'epoch';
+alter table feeds add column hub_pending_mode text not null default '';
+alter table feeds add column hub_pending_expires_at timestamp with time zone null;
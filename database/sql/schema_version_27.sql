@@ -0,0 +1 @@
+alter table feeds add column proxy_url text default '';
@@ -0,0 +1,2 @@
+alter table feeds add column headers text default '';
+alter table feeds add column cookie text default '';
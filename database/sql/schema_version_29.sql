@@ -0,0 +1,18 @@
+create table feed_fetch_log (
+    id bigserial not null,
+    feed_id bigint not null,
+    fetched_at timestamp with time zone not null default now(),
+    status_code int not null default 0,
+    duration int not null default 0,
+    size bigint not null default 0,
+    not_modified bool not null default 'f',
+    created_entries int not null default 0,
+    updated_entries int not null default 0,
+    error_msg text not null default '',
+    previous_url text not null default '',
+    new_url text not null default '',
+    primary key (id),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+create index feed_fetch_log_feed_idx on feed_fetch_log using btree(feed_id, fetched_at);
@@ -0,0 +1 @@
+alter table entries add column crawl_pending bool default 'f';
@@ -0,0 +1,9 @@
+alter table users add column duplicate_policy text default 'none';
+alter table users add column duplicate_match_title bool default 'f';
+alter table entries add column duplicate_of bigint null references entries(id) on delete set null;
+alter table entries add column grouped bool not null default 'f';
+alter table entries add column canonical_url text;
+alter table entries add column normalized_title text;
+create index entries_duplicate_of_idx on entries(duplicate_of);
+create index entries_user_canonical_url_idx on entries(user_id, canonical_url);
+create index entries_user_normalized_title_idx on entries(user_id, normalized_title);
@@ -0,0 +1,12 @@
+create table entry_revisions (
+    id bigserial not null,
+    entry_id bigint not null,
+    title text not null default '',
+    content text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+create index entry_revisions_entry_idx on entry_revisions(entry_id, created_at);
+alter table entries add column updated_at timestamp with time zone;
+alter table feeds add column mark_unread_on_update bool default 'f';
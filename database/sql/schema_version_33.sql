@@ -0,0 +1,3 @@
+alter table feeds add column keep_rules text default '';
+alter table feeds add column block_rules text default '';
+alter table feeds add column filter_action text default 'drop';
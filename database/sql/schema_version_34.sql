@@ -0,0 +1,22 @@
+create table rules (
+    id serial not null,
+    user_id int not null,
+    title text not null,
+    feed_id bigint,
+    category_id int,
+    title_pattern text not null default '',
+    content_pattern text not null default '',
+    author_pattern text not null default '',
+    older_than int not null default 0,
+    mark_as_read bool not null default 'f',
+    star bool not null default 'f',
+    send_to_integrations bool not null default 'f',
+    tag text not null default '',
+    match_count bigint not null default 0,
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade
+);
+
+create index rules_user_idx on rules(user_id);
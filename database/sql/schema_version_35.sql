@@ -0,0 +1,18 @@
+create table tags (
+    id serial not null,
+    user_id int not null,
+    title text not null,
+    primary key (id),
+    unique (user_id, title),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table entry_tags (
+    entry_id bigint not null,
+    tag_id int not null,
+    primary key (entry_id, tag_id),
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (tag_id) references tags(id) on delete cascade
+);
+
+create index entry_tags_tag_idx on entry_tags(tag_id);
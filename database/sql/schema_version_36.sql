@@ -0,0 +1,7 @@
+-- The reading time of the existing entries is estimated as in reader/readingtime: 265 words or 500 CJK characters per minute.
+alter table entries add column reading_time int not null default 0;
+
+update entries set reading_time = ceil(
+    (select count(*) from regexp_matches(regexp_replace(regexp_replace(coalesce(content, ''), '<[^>]*>', ' ', 'g'), '[\u3040-\u30ff\u3400-\u9fff\uac00-\ud7af]', ' ', 'g'), '[[:alnum:]]+', 'g')) / 265.0 +
+    char_length(regexp_replace(regexp_replace(coalesce(content, ''), '<[^>]*>', ' ', 'g'), '[^\u3040-\u30ff\u3400-\u9fff\uac00-\ud7af]', '', 'g')) / 500.0
+);
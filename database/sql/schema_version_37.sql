@@ -0,0 +1,13 @@
+create table site_rules (
+    id serial not null,
+    user_id int,
+    domain text not null,
+    scraper_rules text not null default '',
+    rewrite_rules text not null default '',
+    disabled bool not null default 'f',
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create unique index site_rules_global_domain_idx on site_rules(domain) where user_id is null;
+create unique index site_rules_user_domain_idx on site_rules(user_id, domain) where user_id is not null;
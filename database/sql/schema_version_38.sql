@@ -0,0 +1 @@
+alter table feeds add column keep_tracking_parameters bool default 'f';
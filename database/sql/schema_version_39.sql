@@ -0,0 +1,10 @@
+alter table feeds add column resolve_final_url bool default 'f';
+create table resolved_urls (
+    user_id bigint not null,
+    url text not null,
+    final_url text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id, url),
+    foreign key (user_id) references users(id) on delete cascade
+);
+create index resolved_urls_created_at_idx on resolved_urls(created_at);
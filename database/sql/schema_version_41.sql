@@ -0,0 +1,5 @@
+alter table entries add column subtitle text not null default '';
+alter table entries add column episode int not null default 0;
+alter table entries add column season int not null default 0;
+alter table entries add column explicit bool not null default 'f';
+alter table enclosures add column duration int not null default 0;
@@ -0,0 +1,2 @@
+alter table enclosures add column media_progression int not null default 0;
+alter table users add column mark_read_on_media_completion bool default 'f';
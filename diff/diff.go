@@ -0,0 +1,147 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package diff // import "miniflux.app/diff"
+
+import "strings"
+
+// Operations applied to the words of the text.
+const (
+	OperationEqual  = "equal"
+	OperationInsert = "insert"
+	OperationDelete = "delete"
+)
+
+// Change represents consecutive words kept, added or removed.
+type Change struct {
+	Operation string
+	Text      string
+}
+
+// Changes represents the list of changes between two texts.
+type Changes []*Change
+
+// HasChanges returns true if some words were added or removed.
+func (c Changes) HasChanges() bool {
+	for _, change := range c {
+		if change.Operation != OperationEqual {
+			return true
+		}
+	}
+	return false
+}
+
+// maxComparisons limits the memory used to compare very long texts.
+const maxComparisons = 250000
+
+// Words returns the changes to apply to the words of the first text to get the second one.
+// Whitespaces are not significant. The lines are compared instead of the words when the texts are too long.
+func Words(before, after string) Changes {
+	if changes, ok := compare(strings.Fields(before), strings.Fields(after)); ok {
+		return changes
+	}
+
+	changes, _ := compare(lines(before), lines(after))
+	return changes
+}
+
+// lines returns the non-empty lines of the text, with their whitespaces normalized.
+func lines(text string) []string {
+	var result []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// compare returns the changes to apply to the first list of words to get the second one.
+// When the lists are too long, the differing part is returned as removed then added and ok is false.
+func compare(a, b []string) (changes Changes, ok bool) {
+	add := func(operation, word string) {
+		if n := len(changes); n > 0 && changes[n-1].Operation == operation {
+			changes[n-1].Text += " " + word
+			return
+		}
+		changes = append(changes, &Change{Operation: operation, Text: word})
+	}
+
+	// Most revisions change only a small part of the text.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		add(OperationEqual, a[prefix])
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	common := a[len(a)-suffix:]
+	a = a[prefix : len(a)-suffix]
+	b = b[prefix : len(b)-suffix]
+
+	if len(a)*len(b) > maxComparisons {
+		for _, word := range a {
+			add(OperationDelete, word)
+		}
+		for _, word := range b {
+			add(OperationInsert, word)
+		}
+		for _, word := range common {
+			add(OperationEqual, word)
+		}
+		return changes, false
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			add(OperationEqual, a[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			add(OperationDelete, a[i])
+			i++
+		default:
+			add(OperationInsert, b[j])
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		add(OperationDelete, a[i])
+	}
+
+	for ; j < len(b); j++ {
+		add(OperationInsert, b[j])
+	}
+
+	for _, word := range common {
+		add(OperationEqual, word)
+	}
+
+	return changes, true
+}
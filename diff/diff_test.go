@@ -0,0 +1,82 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package diff // import "miniflux.app/diff"
+
+import "testing"
+
+func TestWords(t *testing.T) {
+	changes := Words("The quick brown fox jumps", "The  quick red fox jumps high")
+
+	expected := Changes{
+		{Operation: OperationEqual, Text: "The quick"},
+		{Operation: OperationDelete, Text: "brown"},
+		{Operation: OperationInsert, Text: "red"},
+		{Operation: OperationEqual, Text: "fox jumps"},
+		{Operation: OperationInsert, Text: "high"},
+	}
+
+	if len(changes) != len(expected) {
+		t.Fatalf(`Unexpected number of changes, got %d instead of %d`, len(changes), len(expected))
+	}
+
+	for i, change := range changes {
+		if *change != *expected[i] {
+			t.Errorf(`Unexpected change #%d, got %+v instead of %+v`, i, *change, *expected[i])
+		}
+	}
+
+	if !changes.HasChanges() {
+		t.Error(`The texts should be different`)
+	}
+}
+
+func TestWordsWithSameText(t *testing.T) {
+	changes := Words("Some  text\n", " Some text")
+
+	if changes.HasChanges() {
+		t.Errorf(`Whitespaces should not be significant, got %+v`, changes)
+	}
+}
+
+func TestWordsWithEmptyText(t *testing.T) {
+	changes := Words("", "New text")
+
+	if len(changes) != 1 || changes[0].Operation != OperationInsert || changes[0].Text != "New text" {
+		t.Errorf(`Unexpected changes: %+v`, changes)
+	}
+
+	if changes := Words("", ""); len(changes) != 0 {
+		t.Errorf(`Empty texts should not have any changes`)
+	}
+}
+
+func TestWordsWithLongText(t *testing.T) {
+	var before, after []byte
+	for i := 0; i < 5000; i++ {
+		before = append(before, "a "...)
+		after = append(after, "b "...)
+	}
+
+	changes := Words("Start "+string(before)+"end", "Start "+string(after)+"end")
+	if len(changes) != 2 || changes[0].Operation != OperationDelete || changes[1].Operation != OperationInsert {
+		t.Errorf(`The lines of very long texts should be compared without the details of their words, got %d changes`, len(changes))
+	}
+}
+
+func TestWordsWithLongTextComparedByLines(t *testing.T) {
+	var before, after []byte
+	for i := 0; i < 1000; i++ {
+		before = append(before, "some words of a line\n"...)
+		after = append(after, "some words of a line\n"...)
+		if i == 500 {
+			after = append(after, "a new line\n"...)
+		}
+	}
+
+	changes := Words(string(before), string(after))
+	if len(changes) != 3 || changes[1].Operation != OperationInsert || changes[1].Text != "a new line" {
+		t.Errorf(`Very long texts should be compared line by line, got %d changes`, len(changes))
+	}
+}
@@ -44,6 +44,9 @@ type Client struct {
 	username            string
 	password            string
 	userAgent           string
+	proxyURL            string
+	headers             map[string]string
+	cookie              string
 	Insecure            bool
 }
 
@@ -77,6 +80,26 @@ func (c *Client) WithUserAgent(userAgent string) *Client {
 	return c
 }
 
+// WithHeaders defines custom headers sent with the request.
+// They take precedence over the default headers.
+func (c *Client) WithHeaders(headers map[string]string) *Client {
+	c.headers = headers
+	return c
+}
+
+// WithCookie defines the value of the Cookie header.
+func (c *Client) WithCookie(cookie string) *Client {
+	c.cookie = cookie
+	return c
+}
+
+// WithProxy defines the proxy to use for this request.
+// The global proxy defined in the configuration is used when the value is empty.
+func (c *Client) WithProxy(proxyURL string) *Client {
+	c.proxyURL = proxyURL
+	return c
+}
+
 // Get execute a GET HTTP request.
 func (c *Client) Get() (*Response, error) {
 	request, err := c.buildRequest(http.MethodGet, nil)
@@ -87,6 +110,16 @@ func (c *Client) Get() (*Response, error) {
 	return c.executeRequest(request)
 }
 
+// Head execute a HEAD HTTP request.
+func (c *Client) Head() (*Response, error) {
+	request, err := c.buildRequest(http.MethodHead, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.executeRequest(request)
+}
+
 // PostForm execute a POST HTTP request with form values.
 func (c *Client) PostForm(values url.Values) (*Response, error) {
 	request, err := c.buildRequest(http.MethodPost, strings.NewReader(values.Encode()))
@@ -117,7 +150,11 @@ func (c *Client) PostJSON(data interface{}) (*Response, error) {
 func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[HttpClient] url=%s", c.url))
 
-	client := c.buildClient()
+	client, err := c.buildClient()
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := client.Do(request)
 	if resp != nil {
 		defer resp.Body.Close()
@@ -147,7 +184,8 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 		return nil, err
 	}
 
-	if resp.ContentLength > config.Opts.HTTPClientMaxBodySize() {
+	// The response to a HEAD request has no body, its length is not limited.
+	if request.Method != http.MethodHead && resp.ContentLength > config.Opts.HTTPClientMaxBodySize() {
 		return nil, fmt.Errorf("client: response too large (%d bytes)", resp.ContentLength)
 	}
 
@@ -160,13 +198,22 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 		Body:          bytes.NewReader(buf),
 		StatusCode:    resp.StatusCode,
 		EffectiveURL:  resp.Request.URL.String(),
+		Redirects:     redirectChain(resp),
 		LastModified:  resp.Header.Get("Last-Modified"),
 		ETag:          resp.Header.Get("ETag"),
+		CacheControl:  resp.Header.Get("Cache-Control"),
+		Expires:       resp.Header.Get("Expires"),
+		RetryAfter:    resp.Header.Get("Retry-After"),
 		ContentType:   resp.Header.Get("Content-Type"),
 		ContentLength: resp.ContentLength,
+		BodySize:      int64(len(buf)),
+	}
+
+	for _, redirect := range response.Redirects {
+		logger.Debug("[HttpClient:%s] Redirect %s -> %s (Code=%d)", request.Method, redirect.URL, redirect.Location, redirect.StatusCode)
 	}
 
-	logger.Debug("[HttpClient:%s] URL=%s, EffectiveURL=%s, Code=%d, Length=%d, Type=%s, ETag=%s, LastMod=%s, Expires=%s, Auth=%v",
+	logger.Debug("[HttpClient:%s] URL=%s, EffectiveURL=%s, Code=%d, Length=%d, Type=%s, ETag=%s, LastMod=%s, CacheControl=%s, Expires=%s, Auth=%v",
 		request.Method,
 		c.url,
 		response.EffectiveURL,
@@ -175,12 +222,13 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 		response.ContentType,
 		response.ETag,
 		response.LastModified,
-		resp.Header.Get("Expires"),
+		response.CacheControl,
+		response.Expires,
 		c.username != "",
 	)
 
 	// Ignore caching headers for feeds that do not want any cache.
-	if resp.Header.Get("Expires") == "0" {
+	if response.Expires == "0" {
 		logger.Debug("[HttpClient] Ignore caching headers for %q", response.EffectiveURL)
 		response.ETag = ""
 		response.LastModified = ""
@@ -189,6 +237,20 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 	return response, err
 }
 
+// redirectChain returns the redirections followed to get the response, in order.
+func redirectChain(resp *http.Response) []Redirect {
+	var redirects []Redirect
+	for request := resp.Request; request != nil && request.Response != nil; request = request.Response.Request {
+		redirect := Redirect{
+			URL:        request.Response.Request.URL.String(),
+			StatusCode: request.Response.StatusCode,
+			Location:   request.URL.String(),
+		}
+		redirects = append([]Redirect{redirect}, redirects...)
+	}
+	return redirects
+}
+
 func (c *Client) buildRequest(method string, body io.Reader) (*http.Request, error) {
 	request, err := http.NewRequest(method, c.url, body)
 	if err != nil {
@@ -204,15 +266,78 @@ func (c *Client) buildRequest(method string, body io.Reader) (*http.Request, err
 	return request, nil
 }
 
-func (c *Client) buildClient() http.Client {
+func (c *Client) buildClient() (http.Client, error) {
 	client := http.Client{Timeout: time.Duration(config.Opts.HTTPClientTimeout()) * time.Second}
-	if c.Insecure {
-		client.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+
+	proxyURL := c.proxyURL
+	if proxyURL == "" {
+		proxyURL = config.Opts.HTTPClientProxy()
+	}
+
+	if c.Insecure || proxyURL != "" {
+		transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+
+		if c.Insecure {
+			transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 		}
+
+		if proxyURL != "" {
+			proxy, err := parseProxyURL(proxyURL)
+			if err != nil {
+				return client, err
+			}
+
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+
+		client.Transport = transport
 	}
 
-	return client
+	return client, nil
+}
+
+// ValidateProxyURL returns an error if the proxy URL is not supported.
+func ValidateProxyURL(proxyURL string) error {
+	_, err := parseProxyURL(proxyURL)
+	return err
+}
+
+// ValidateHeaders returns an error if a custom header or the cookie cannot be sent.
+func ValidateHeaders(headers map[string]string, cookie string) error {
+	for name, value := range headers {
+		if name == "" || strings.ContainsAny(name, " \t\r\n:") {
+			return fmt.Errorf("client: invalid header name %q", name)
+		}
+
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("client: invalid value for the header %q", name)
+		}
+	}
+
+	if strings.ContainsAny(cookie, "\r\n") {
+		return fmt.Errorf("client: invalid cookie value")
+	}
+
+	return nil
+}
+
+func parseProxyURL(proxyURL string) (*url.URL, error) {
+	proxy, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("client: invalid proxy URL: %v", err)
+	}
+
+	switch proxy.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("client: unsupported proxy scheme %q", proxy.Scheme)
+	}
+
+	if proxy.Host == "" {
+		return nil, fmt.Errorf("client: the proxy URL %q has no host", proxyURL)
+	}
+
+	return proxy, nil
 }
 
 func (c *Client) buildHeaders() http.Header {
@@ -232,6 +357,14 @@ func (c *Client) buildHeaders() http.Header {
 		headers.Add("Authorization", c.authorizationHeader)
 	}
 
+	for name, value := range c.headers {
+		headers.Set(name, value)
+	}
+
+	if c.cookie != "" {
+		headers.Set("Cookie", c.cookie)
+	}
+
 	headers.Add("Connection", "close")
 	return headers
 }
@@ -9,8 +9,11 @@ import (
 	"io"
 	"io/ioutil"
 	"mime"
+	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"golang.org/x/net/html/charset"
@@ -18,15 +21,32 @@ import (
 
 var xmlEncodingRegex = regexp.MustCompile(`<\?xml(.*)encoding=["'](.+)["'](.*)\?>`)
 
+// Redirect represents a HTTP redirection followed by the client.
+type Redirect struct {
+	URL        string
+	StatusCode int
+	Location   string
+}
+
+// IsPermanent returns true if the redirection is permanent.
+func (r Redirect) IsPermanent() bool {
+	return r.StatusCode == 301 || r.StatusCode == 308
+}
+
 // Response wraps a server response.
 type Response struct {
 	Body          io.Reader
 	StatusCode    int
 	EffectiveURL  string
+	Redirects     []Redirect
 	LastModified  string
 	ETag          string
+	CacheControl  string
+	Expires       string
+	RetryAfter    string
 	ContentType   string
 	ContentLength int64
+	BodySize      int64
 }
 
 // IsNotFound returns true if the resource doesn't exists anymore.
@@ -34,6 +54,11 @@ func (r *Response) IsNotFound() bool {
 	return r.StatusCode == 404 || r.StatusCode == 410
 }
 
+// IsGone returns true if the resource has been removed permanently.
+func (r *Response) IsGone() bool {
+	return r.StatusCode == 410
+}
+
 // IsNotAuthorized returns true if the resource require authentication.
 func (r *Response) IsNotAuthorized() bool {
 	return r.StatusCode == 401
@@ -44,6 +69,26 @@ func (r *Response) HasServerFailure() bool {
 	return r.StatusCode >= 400
 }
 
+// PermanentURL returns the URL that should be used for subsequent requests.
+//
+// Permanent redirects are followed until the first temporary one,
+// the original URL is kept when the resource has been moved temporarily.
+func (r *Response) PermanentURL() string {
+	if len(r.Redirects) == 0 {
+		return r.EffectiveURL
+	}
+
+	url := r.Redirects[0].URL
+	for _, redirect := range r.Redirects {
+		if !redirect.IsPermanent() {
+			break
+		}
+		url = redirect.Location
+	}
+
+	return url
+}
+
 // IsModified returns true if the resource has been modified.
 func (r *Response) IsModified(etag, lastModified string) bool {
 	if r.StatusCode == 304 {
@@ -61,6 +106,57 @@ func (r *Response) IsModified(etag, lastModified string) bool {
 	return true
 }
 
+// CacheMaxAge returns how long the server wants the resource to be cached, or zero if not specified.
+//
+// The "max-age" directive of the Cache-Control header takes precedence over the Expires header.
+func (r *Response) CacheMaxAge() time.Duration {
+	for _, directive := range strings.Split(r.CacheControl, ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		if strings.HasPrefix(directive, "max-age=") {
+			seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err == nil && seconds > 0 {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	if r.Expires != "" {
+		expires, err := http.ParseTime(r.Expires)
+		if err == nil && time.Until(expires) > 0 {
+			return time.Until(expires)
+		}
+	}
+
+	return 0
+}
+
+// RetryDelay returns how long the client should wait before retrying, according to the Retry-After header.
+//
+// The header is only meaningful for "429 Too Many Requests" and "503 Service Unavailable" responses.
+func (r *Response) RetryDelay() time.Duration {
+	if r.StatusCode != 429 && r.StatusCode != 503 {
+		return 0
+	}
+
+	value := strings.TrimSpace(r.RetryAfter)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		return 0
+	}
+
+	if retryAt, err := http.ParseTime(value); err == nil && time.Until(retryAt) > 0 {
+		return time.Until(retryAt)
+	}
+
+	return 0
+}
+
 // EnsureUnicodeBody makes sure the body is encoded in UTF-8.
 //
 // If a charset other than UTF-8 is detected, we convert the document to UTF-8.
@@ -7,8 +7,10 @@ package client // import "miniflux.app/http/client"
 import (
 	"bytes"
 	"io/ioutil"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 	"unicode/utf8"
 )
 
@@ -29,6 +31,23 @@ func TestIsNotFound(t *testing.T) {
 	}
 }
 
+func TestIsGone(t *testing.T) {
+	scenarios := map[int]bool{
+		200: false,
+		404: false,
+		410: true,
+	}
+
+	for input, expected := range scenarios {
+		r := &Response{StatusCode: input}
+		actual := r.IsGone()
+
+		if actual != expected {
+			t.Errorf(`Unexpected result, got %v instead of %v for status code %d`, actual, expected, input)
+		}
+	}
+}
+
 func TestIsNotAuthorized(t *testing.T) {
 	scenarios := map[int]bool{
 		200: false,
@@ -146,3 +165,122 @@ func TestEnsureUnicodeWithHTMLDocuments(t *testing.T) {
 		}
 	}
 }
+
+func TestCacheMaxAgeWithCacheControl(t *testing.T) {
+	r := &Response{CacheControl: "public, max-age=3600"}
+	if r.CacheMaxAge() != time.Hour {
+		t.Errorf(`Unexpected cache max age, got %v instead of %v`, r.CacheMaxAge(), time.Hour)
+	}
+}
+
+func TestCacheMaxAgeWithExpires(t *testing.T) {
+	r := &Response{Expires: time.Now().Add(2 * time.Hour).UTC().Format(http.TimeFormat)}
+	if r.CacheMaxAge() <= time.Hour || r.CacheMaxAge() > 2*time.Hour {
+		t.Errorf(`Unexpected cache max age, got %v`, r.CacheMaxAge())
+	}
+}
+
+func TestCacheMaxAgePrefersCacheControl(t *testing.T) {
+	r := &Response{CacheControl: "max-age=60", Expires: time.Now().Add(2 * time.Hour).UTC().Format(http.TimeFormat)}
+	if r.CacheMaxAge() != time.Minute {
+		t.Errorf(`Unexpected cache max age, got %v instead of %v`, r.CacheMaxAge(), time.Minute)
+	}
+}
+
+func TestCacheMaxAgeWithoutHeaders(t *testing.T) {
+	scenarios := []*Response{
+		&Response{},
+		&Response{CacheControl: "no-cache"},
+		&Response{CacheControl: "max-age=0"},
+		&Response{Expires: "0"},
+		&Response{Expires: "Thu, 01 Jan 1970 00:00:00 GMT"},
+	}
+
+	for _, r := range scenarios {
+		if r.CacheMaxAge() != 0 {
+			t.Errorf(`Unexpected cache max age, got %v instead of 0 for %+v`, r.CacheMaxAge(), r)
+		}
+	}
+}
+
+func TestRetryDelayWithSeconds(t *testing.T) {
+	r := &Response{StatusCode: 429, RetryAfter: "120"}
+	if r.RetryDelay() != 2*time.Minute {
+		t.Errorf(`Unexpected retry delay, got %v instead of %v`, r.RetryDelay(), 2*time.Minute)
+	}
+}
+
+func TestRetryDelayWithDate(t *testing.T) {
+	r := &Response{StatusCode: 503, RetryAfter: time.Now().Add(2 * time.Hour).UTC().Format(http.TimeFormat)}
+	if r.RetryDelay() <= time.Hour || r.RetryDelay() > 2*time.Hour {
+		t.Errorf(`Unexpected retry delay, got %v`, r.RetryDelay())
+	}
+}
+
+func TestRetryDelayIgnoredForOtherStatusCodes(t *testing.T) {
+	scenarios := []*Response{
+		&Response{StatusCode: 200, RetryAfter: "120"},
+		&Response{StatusCode: 500, RetryAfter: "120"},
+		&Response{StatusCode: 429},
+		&Response{StatusCode: 429, RetryAfter: "invalid"},
+		&Response{StatusCode: 503, RetryAfter: "Thu, 01 Jan 1970 00:00:00 GMT"},
+	}
+
+	for _, r := range scenarios {
+		if r.RetryDelay() != 0 {
+			t.Errorf(`Unexpected retry delay, got %v instead of 0 for %+v`, r.RetryDelay(), r)
+		}
+	}
+}
+
+func TestPermanentURLWithoutRedirect(t *testing.T) {
+	r := &Response{EffectiveURL: "https://example.org/feed.xml"}
+	if r.PermanentURL() != "https://example.org/feed.xml" {
+		t.Errorf(`Unexpected URL, got %q`, r.PermanentURL())
+	}
+}
+
+func TestPermanentURLWithPermanentRedirects(t *testing.T) {
+	r := &Response{
+		EffectiveURL: "https://example.com/feed.xml",
+		Redirects: []Redirect{
+			{URL: "http://example.org/feed.xml", StatusCode: 301, Location: "https://example.org/feed.xml"},
+			{URL: "https://example.org/feed.xml", StatusCode: 308, Location: "https://example.com/feed.xml"},
+		},
+	}
+
+	if r.PermanentURL() != "https://example.com/feed.xml" {
+		t.Errorf(`Unexpected URL, got %q`, r.PermanentURL())
+	}
+}
+
+func TestPermanentURLWithTemporaryRedirect(t *testing.T) {
+	scenarios := []int{302, 303, 307}
+
+	for _, statusCode := range scenarios {
+		r := &Response{
+			EffectiveURL: "https://cdn.example.org/feed.xml",
+			Redirects: []Redirect{
+				{URL: "https://example.org/feed.xml", StatusCode: statusCode, Location: "https://cdn.example.org/feed.xml"},
+			},
+		}
+
+		if r.PermanentURL() != "https://example.org/feed.xml" {
+			t.Errorf(`Unexpected URL for status code %d, got %q`, statusCode, r.PermanentURL())
+		}
+	}
+}
+
+func TestPermanentURLStopsAtTemporaryRedirect(t *testing.T) {
+	r := &Response{
+		EffectiveURL: "https://login.example.com/",
+		Redirects: []Redirect{
+			{URL: "http://example.org/feed.xml", StatusCode: 301, Location: "https://example.org/feed.xml"},
+			{URL: "https://example.org/feed.xml", StatusCode: 302, Location: "https://login.example.com/"},
+		},
+	}
+
+	if r.PermanentURL() != "https://example.org/feed.xml" {
+		t.Errorf(`Unexpected URL, got %q`, r.PermanentURL())
+	}
+}
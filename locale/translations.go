@@ -26,6 +26,7 @@ var translations = map[string]string{
     "menu.history": "Verlauf",
     "menu.feeds": "Abonnements",
     "menu.categories": "Kategorien",
+    "menu.tags": "Schlagwörter",
     "menu.settings": "Einstellungen",
     "menu.logout": "Abmelden",
     "menu.preferences": "Einstellungen",
@@ -33,14 +34,20 @@ var translations = map[string]string{
     "menu.sessions": "Sitzungen",
     "menu.users": "Benutzer",
     "menu.about": "Über",
+    "menu.rules": "Regeln",
     "menu.export": "Exportieren",
     "menu.import": "Importieren",
     "menu.create_category": "Kategorie anlegen",
+    "menu.create_rule": "Regel erstellen",
+    "menu.site_rules": "Website-Regeln",
+    "menu.create_site_rule": "Website-Regel anlegen",
     "menu.mark_page_as_read": "Diese Seite als gelesen markieren",
     "menu.mark_all_as_read": "Alle als gelesen markieren",
     "menu.mark_all_as_read_wip": "In Arbeit...",
     "menu.show_all_entries": "Zeige alle Artikel",
     "menu.show_only_unread_entries": "Nur ungelesene Artikel anzeigen",
+    "menu.show_only_short_reads": "Nur kurze Artikel anzeigen (unter 5 Minuten)",
+    "menu.show_all_reading_times": "Artikel jeder Lesedauer anzeigen",
     "menu.refresh_feed": "Aktualisieren",
     "menu.refresh_all_feeds": "Alle Abonnements im Hintergrund aktualisieren",
     "menu.edit_feed": "Bearbeiten",
@@ -68,14 +75,59 @@ var translations = map[string]string{
     "entry.original.label": "Original-Artikel",
     "entry.comments.label": "Kommentare",
     "entry.comments.title": "Kommentare anzeigen",
+    "entry.estimated_reading_time": [
+        "%d Minute zu lesen",
+        "%d Minuten zu lesen"
+    ],
+    "entry.revisions.label": "Änderungen",
+    "entry.podcast.season": "Staffel %d",
+    "entry.podcast.episode": "Folge %d",
+    "entry.podcast.explicit": "Explizit",
+    "entry.media.playback_speed": "Wiedergabegeschwindigkeit",
+    "entry.revisions.title": "Die Änderungen an diesem Artikel anzeigen",
+    "entry.updated": "aktualisiert",
     "page.unread.title": "Ungelesen",
     "page.starred.title": "Lesezeichen",
     "page.categories.title": "Kategorien",
+    "page.tags.title": "Schlagwörter",
+    "page.tags.entry_count": [
+        "Es gibt %d Artikel.",
+        "Es gibt %d Artikel."
+    ],
     "page.categories.no_feed": "Kein Abonnement.",
     "page.categories.feed_count": [
         "Es gibt %d Abonnement.",
         "Es gibt %d Abonnements."
     ],
+    "page.rules.title": "Regeln",
+    "page.new_rule.title": "Neue Regel",
+    "page.edit_rule.title": "Regel bearbeiten: %s",
+    "page.rules.feed": "Abonnement: %s",
+    "page.rules.category": "Kategorie: %s",
+    "page.rules.title_pattern": "Titel: %s",
+    "page.rules.content_pattern": "Inhalt: %s",
+    "page.rules.author_pattern": "Autor: %s",
+    "page.rules.older_than": [
+        "Älter als %d Tag",
+        "Älter als %d Tage"
+    ],
+    "page.rules.match_count": [
+        "%d passender Artikel",
+        "%d passende Artikel"
+    ],
+    "page.rules.apply": "Auf vorhandene Artikel anwenden",
+    "page.site_rules.title": "Website-Regeln",
+    "page.new_site_rule.title": "Neue Website-Regel",
+    "page.edit_site_rule.title": "Website-Regel bearbeiten: %s",
+    "page.site_rules.help": "Website-Regeln werden verwendet, wenn ein Abonnement keine eigenen Extraktions- oder Umschreiberegeln definiert. Ihre Regeln haben Vorrang vor den mit allen Benutzern geteilten Regeln. Eine deaktivierte Regel schaltet die vordefinierten Regeln für die Domain ab.",
+    "page.site_rules.global": "Mit allen Benutzern geteilt",
+    "page.site_rules.private": "Privat",
+    "page.site_rules.disabled": "Deaktiviert",
+    "page.site_rules.scraper_rules": "Extraktionsregeln: %s",
+    "page.site_rules.rewrite_rules": "Umschreiberegeln: %s",
+    "page.site_rules.builtin": "Eingebaute Regeln",
+    "page.site_rules.override": "Überschreiben",
+    "page.site_rules.global_help": "Diese Regel wird mit allen Benutzern geteilt.",
     "page.new_category.title": "Neue Kategorie",
     "page.new_user.title": "Neuer Benutzer",
     "page.edit_category.title": "Kategorie bearbeiten: %s",
@@ -86,6 +138,8 @@ var translations = map[string]string{
         "%d Fehler",
         "%d Fehler"
     ],
+    "page.feeds.next_retry": "Nächster Versuch:",
+    "page.feeds.disabled": "Deaktiviert",
     "page.history.title": "Verlauf",
     "page.import.title": "Importieren",
     "page.search.title": "Suchergebnisse",
@@ -103,11 +157,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Abonnement auswählen",
     "page.edit_feed.title": "Abonnement bearbeiten: %s",
     "page.edit_feed.last_check": "Letzte Aktualisierung:",
+    "page.edit_feed.next_check": "Nächste Aktualisierung:",
+    "page.edit_feed.websub_hub": "WebSub-Hub:",
+    "page.edit_feed.websub_lease": "Aktualisierungen werden bis %s übertragen",
     "page.edit_feed.last_modified_header": "Zuletzt geändert:",
     "page.edit_feed.etag_header": "ETag-Kopfzeile:",
     "page.edit_feed.no_header": "Nicht verfügbar",
     "page.edit_feed.last_parsing_error": "Letzter Analysefehler",
+    "page.edit_feed.fetch_history": "Abrufverlauf",
+    "page.edit_feed.fetch_history.date": "Datum",
+    "page.edit_feed.fetch_history.status": "HTTP-Status",
+    "page.edit_feed.fetch_history.duration": "Dauer",
+    "page.edit_feed.fetch_history.size": "Größe (Bytes)",
+    "page.edit_feed.fetch_history.entries": "Artikel",
+    "page.edit_feed.fetch_history.entries_count": "%d neu, %d aktualisiert",
+    "page.edit_feed.fetch_history.error": "Fehler",
+    "page.edit_feed.fetch_history.not_modified": "nicht geändert",
+    "page.edit_feed.fetch_history.moved": "Verschoben von %s nach %s",
     "page.entry.attachments": "Anlagen",
+    "page.entry.duplicates": "Auch veröffentlicht in",
+    "page.entry.tags": "Schlagwörter",
+    "page.entry_revisions.title": "Änderungen des Artikels",
+    "page.entry_revisions.changed_at": "Geändert",
+    "page.entry_revisions.same_content": "Der Inhalt wurde nicht geändert.",
     "page.keyboard_shortcuts.title": "Tastenkürzel",
     "page.keyboard_shortcuts.subtitle.sections": "Navigation zwischen den Menüpunkten",
     "page.keyboard_shortcuts.subtitle.items": "Navigation zwischen den Artikeln",
@@ -165,11 +237,20 @@ var translations = map[string]string{
     "page.sessions.table.actions": "Aktionen",
     "page.sessions.table.current_session": "Aktuelle Sitzung",
     "alert.no_bookmark": "Es existiert derzeit kein Lesezeichen.",
+    "alert.no_tag": "Es gibt derzeit keine Schlagwörter.",
+    "alert.no_tag_entry": "Es gibt keinen Artikel mit diesem Schlagwort.",
     "alert.no_category": "Es ist keine Kategorie vorhanden.",
     "alert.no_category_entry": "Es befindet sich kein Artikel in dieser Kategorie.",
     "alert.no_feed_entry": "Es existiert kein Artikel für dieses Abonnement.",
     "alert.no_feed": "Es sind keine Abonnements vorhanden.",
     "alert.no_history": "Es existiert zur Zeit kein Verlauf.",
+    "alert.no_revision": "Es gibt keine früheren Versionen dieses Artikels.",
+    "alert.no_rule": "Es gibt keine Regel.",
+    "alert.no_site_rule": "Es gibt keine Website-Regel.",
+    "alert.rule_applied": [
+        "Die Regel hat %d Artikel gefunden.",
+        "Die Regel hat %d Artikel gefunden."
+    ],
     "alert.feed_error": "Es gibt ein Problem mit diesem Abonnement",
     "alert.no_search_result": "Es gibt kein Ergebnis für diese Suche.",
     "alert.no_unread_entry": "Es existiert kein ungelesener Artikel.",
@@ -194,6 +275,23 @@ var translations = map[string]string{
     "error.empty_file": "Diese Datei ist leer.",
     "error.bad_credentials": "Benutzername oder Passwort ungültig.",
     "error.fields_mandatory": "Alle Felder sind obligatorisch.",
+    "error.invalid_proxy_url": "Ungültige Proxy-URL, nur http-, https- und socks5-Proxys werden unterstützt.",
+    "error.invalid_duplicate_policy": "Ungültige Regel für doppelte Artikel.",
+    "error.invalid_filter_rules": "Ungültige Filterregeln.",
+    "error.rule_condition_required": "Die Regel muss mindestens eine Bedingung haben.",
+    "error.rule_action_required": "Die Regel muss mindestens eine Aktion haben.",
+    "error.rule_condition_not_found": "Das ausgewählte Abonnement oder die Kategorie existiert nicht.",
+    "error.invalid_rule_age": "Das Alter der Artikel muss eine positive Anzahl von Tagen sein.",
+    "error.invalid_rule_pattern": "Ungültiger regulärer Ausdruck: %s",
+    "error.unable_to_create_rule": "Diese Regel kann nicht erstellt werden.",
+    "error.unable_to_update_rule": "Diese Regel kann nicht aktualisiert werden.",
+    "error.domain_required": "Die Domain ist obligatorisch.",
+    "error.invalid_domain": "Ungültige Domain %q, nur der Hostname wird erwartet (zum Beispiel: example.org).",
+    "error.site_rule_already_exists": "Für diese Domain existiert bereits eine Website-Regel.",
+    "error.unable_to_create_site_rule": "Diese Website-Regel konnte nicht angelegt werden.",
+    "error.unable_to_update_site_rule": "Diese Website-Regel konnte nicht aktualisiert werden.",
+    "error.invalid_headers": "Ungültige benutzerdefinierte Header oder Cookie.",
+    "error.encryption_key_missing": "Benutzerdefinierte Header und Cookies können nicht gespeichert werden, da kein Verschlüsselungsschlüssel konfiguriert ist (ENCRYPTION_KEY).",
     "error.title_required": "Der Titel ist obligatorisch.",
     "error.different_passwords": "Passwörter stimmen nicht überein.",
     "error.password_min_length": "Wenigstens 6 Zeichen müssen genutzt werden.",
@@ -201,15 +299,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "Die URL und die Kategorie sind obligatorisch.",
     "error.user_mandatory_fields": "Der Benutzername ist obligatorisch.",
     "form.feed.label.title": "Titel",
+    "form.entry.label.tags": "Schlagwörter (durch Kommas getrennt)",
     "form.feed.label.site_url": "Webseite-URL",
     "form.feed.label.feed_url": "Abonnement-URL",
     "form.feed.label.category": "Kategorie",
     "form.feed.label.crawler": "Inhalt herunterladen",
+    "form.feed.label.mark_unread_on_update": "Aktualisierte Artikel als ungelesen markieren",
+    "form.feed.label.keep_tracking_parameters": "Tracking-Parameter der Links behalten (utm_source, fbclid...)",
+    "form.feed.label.resolve_final_url": "Weiterleitungen der Artikel-Links folgen, um ihre endgültige URL zu speichern (FeedBurner, URL-Kürzer...)",
+    "form.feed.label.disabled": "Dieses Abonnement nicht aktualisieren",
     "form.feed.label.feed_username": "Benutzername des Abonnements",
     "form.feed.label.feed_password": "Passwort des Abonnements",
     "form.feed.label.user_agent": "Standardbenutzeragenten überschreiben",
+    "form.feed.label.proxy_url": "Proxy-URL (http, https oder socks5)",
+    "form.feed.label.headers": "Benutzerdefinierte Header (ein \"Name: Wert\" pro Zeile)",
+    "form.feed.label.cookie": "Cookie",
     "form.feed.label.scraper_rules": "Extraktionsregeln",
     "form.feed.label.rewrite_rules": "Umschreiberegeln",
+    "form.feed.label.keep_rules": "Nur Artikel behalten, die diesen Regeln entsprechen",
+    "form.feed.label.block_rules": "Artikel blockieren, die diesen Regeln entsprechen",
+    "form.feed.help.filter_rules": "Ein regulärer Ausdruck pro Zeile, optional mit dem Feld als Präfix: title, content, author, url oder category.",
+    "form.feed.help.scraper_rules": "CSS-Selektoren des Inhalts, optional gefolgt von \"!\" und den CSS-Selektoren der zu entfernenden Elemente.",
+    "form.feed.help.rewrite_rules": "Durch Kommas getrennte Regeln: add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"Ersetzung\"), replace_title(\"regex\"|\"Ersetzung\"), remove(\"CSS-Selektor\") und base64_decode(\"CSS-Selektor\").",
+    "form.feed.help.undecryptable_headers": "Die gespeicherten Header oder das Cookie können mit dem aktuellen Verschlüsselungsschlüssel nicht entschlüsselt werden. Sie werden nicht gesendet und beim Speichern durch die Werte dieses Formulars ersetzt.",
+    "form.feed.label.filter_action": "Blockierte Artikel",
+    "form.feed.select.filter_drop": "Ignorieren",
+    "form.feed.select.filter_read": "Als gelesen speichern",
+    "form.rule.label.title": "Titel",
+    "form.rule.section.conditions": "Bedingungen",
+    "form.rule.section.actions": "Aktionen",
+    "form.rule.label.feed": "Abonnement",
+    "form.rule.select.any_feed": "Alle Abonnements",
+    "form.rule.label.category": "Kategorie",
+    "form.rule.select.any_category": "Alle Kategorien",
+    "form.rule.label.title_pattern": "Titel entspricht",
+    "form.rule.label.content_pattern": "Inhalt entspricht",
+    "form.rule.label.author_pattern": "Autor entspricht",
+    "form.rule.label.older_than": "Vor mehr als N Tagen veröffentlicht",
+    "form.rule.help.conditions": "Die Artikel müssen alle Bedingungen erfüllen, leere Bedingungen werden ignoriert. Die Muster sind reguläre Ausdrücke.",
+    "form.rule.label.mark_as_read": "Als gelesen markieren",
+    "form.rule.label.star": "Lesezeichen hinzufügen",
+    "form.rule.label.send_to_integrations": "An Drittanbieter-Dienste senden",
+    "form.rule.label.tag": "Schlagwort hinzufügen",
+    "form.site_rule.label.domain": "Domain",
+    "form.site_rule.label.disabled": "Vordefinierte Regeln für diese Domain deaktivieren",
+    "form.site_rule.label.global": "Diese Regel mit allen Benutzern teilen",
     "form.category.label.title": "Titel",
     "form.user.label.username": "Benutzername",
     "form.user.label.password": "Passwort",
@@ -222,6 +356,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Älteste Artikel zuerst",
     "form.prefs.select.recent_first": "Neueste Artikel zuerst",
     "form.prefs.label.keyboard_shortcuts": "Tastaturkürzel aktivieren",
+    "form.prefs.label.duplicate_policy": "Bereits von einem anderen Abonnement veröffentlichte Artikel",
+    "form.prefs.select.duplicate_none": "Ungelesen lassen",
+    "form.prefs.select.duplicate_read": "Als gelesen markieren",
+    "form.prefs.select.duplicate_group": "Unter dem ersten Artikel gruppieren",
+    "form.prefs.label.duplicate_match_title": "Doppelte Artikel auch anhand des Titels erkennen",
+    "form.prefs.label.mark_read_on_media_completion": "Artikel mit Podcast oder Video erst nach dem Ende der Wiedergabe als gelesen markieren",
     "form.import.label.file": "OPML Datei",
     "form.integration.fever_activate": "Fever API aktivieren",
     "form.integration.fever_username": "Fever Benutzername",
@@ -295,7 +435,8 @@ var translations = map[string]string{
     "Website unreachable, the request timed out after %d seconds": "Webseite nicht erreichbar, die Anfrage endete nach %d Sekunden",
     "You are not authorized to access this resource (invalid username/password)": "Sie sind nicht berechtigt, auf diese Ressource zuzugreifen (Benutzername/Passwort ungültig)",
     "Unable to fetch this resource (Status Code = %d)": "Ressource konnte nicht abgerufen werden (code=%d)",
-    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Ressource nicht gefunden (404), dieses Abonnement existiert nicht mehr, überprüfen Sie die Abonnement-URL"
+    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Ressource nicht gefunden (404), dieses Abonnement existiert nicht mehr, überprüfen Sie die Abonnement-URL",
+    "This feed has been removed permanently (410), it will not be refreshed anymore": "Dieses Abonnement wurde dauerhaft entfernt (410), es wird nicht mehr aktualisiert"
 }
 `,
 	"en_US": `{
@@ -321,6 +462,7 @@ var translations = map[string]string{
     "menu.history": "History",
     "menu.feeds": "Feeds",
     "menu.categories": "Categories",
+    "menu.tags": "Tags",
     "menu.settings": "Settings",
     "menu.logout": "Logout",
     "menu.preferences": "Preferences",
@@ -328,14 +470,20 @@ var translations = map[string]string{
     "menu.sessions": "Sessions",
     "menu.users": "Users",
     "menu.about": "About",
+    "menu.rules": "Rules",
     "menu.export": "Export",
     "menu.import": "Import",
     "menu.create_category": "Create a category",
+    "menu.create_rule": "Create a rule",
+    "menu.site_rules": "Site rules",
+    "menu.create_site_rule": "Create a site rule",
     "menu.mark_page_as_read": "Mark this page as read",
     "menu.mark_all_as_read": "Mark all as read",
     "menu.mark_all_as_read_wip": "Operation in progress...",
     "menu.show_all_entries": "Show all entries",
     "menu.show_only_unread_entries": "Show only unread entries",
+    "menu.show_only_short_reads": "Show only short reads (under 5 minutes)",
+    "menu.show_all_reading_times": "Show entries of any reading time",
     "menu.refresh_feed": "Refresh",
     "menu.refresh_all_feeds": "Refresh all feeds in the background",
     "menu.edit_feed": "Edit",
@@ -363,14 +511,59 @@ var translations = map[string]string{
     "entry.original.label": "Original",
     "entry.comments.label": "Comments",
     "entry.comments.title": "View Comments",
+    "entry.estimated_reading_time": [
+        "%d minute read",
+        "%d minutes read"
+    ],
+    "entry.revisions.label": "Changes",
+    "entry.podcast.season": "Season %d",
+    "entry.podcast.episode": "Episode %d",
+    "entry.podcast.explicit": "Explicit",
+    "entry.media.playback_speed": "Playback speed",
+    "entry.revisions.title": "View the changes made to this article",
+    "entry.updated": "updated",
     "page.unread.title": "Unread",
     "page.starred.title": "Starred",
     "page.categories.title": "Categories",
+    "page.tags.title": "Tags",
+    "page.tags.entry_count": [
+        "There is %d article.",
+        "There are %d articles."
+    ],
     "page.categories.no_feed": "No feed.",
     "page.categories.feed_count": [
         "There is %d feed.",
         "There are %d feeds."
     ],
+    "page.rules.title": "Rules",
+    "page.new_rule.title": "New Rule",
+    "page.edit_rule.title": "Edit Rule: %s",
+    "page.rules.feed": "Feed: %s",
+    "page.rules.category": "Category: %s",
+    "page.rules.title_pattern": "Title: %s",
+    "page.rules.content_pattern": "Content: %s",
+    "page.rules.author_pattern": "Author: %s",
+    "page.rules.older_than": [
+        "Older than %d day",
+        "Older than %d days"
+    ],
+    "page.rules.match_count": [
+        "%d article matched",
+        "%d articles matched"
+    ],
+    "page.rules.apply": "Apply to existing articles",
+    "page.site_rules.title": "Site Rules",
+    "page.new_site_rule.title": "New Site Rule",
+    "page.edit_site_rule.title": "Edit Site Rule: %s",
+    "page.site_rules.help": "Site rules are used when a feed does not define its own scraper or rewrite rules. Your rules take precedence over the ones shared with all users. A disabled rule turns off the predefined rules for the domain.",
+    "page.site_rules.global": "Shared with all users",
+    "page.site_rules.private": "Private",
+    "page.site_rules.disabled": "Disabled",
+    "page.site_rules.scraper_rules": "Scraper rules: %s",
+    "page.site_rules.rewrite_rules": "Rewrite rules: %s",
+    "page.site_rules.builtin": "Built-in rules",
+    "page.site_rules.override": "Override",
+    "page.site_rules.global_help": "This rule is shared with all users.",
     "page.new_category.title": "New Category",
     "page.new_user.title": "New User",
     "page.edit_category.title": "Edit Category: %s",
@@ -381,6 +574,8 @@ var translations = map[string]string{
         "%d error",
         "%d errors"
     ],
+    "page.feeds.next_retry": "Next retry:",
+    "page.feeds.disabled": "Disabled",
     "page.history.title": "History",
     "page.import.title": "Import",
     "page.search.title": "Search Results",
@@ -398,11 +593,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Choose a Subscription",
     "page.edit_feed.title": "Edit Feed: %s",
     "page.edit_feed.last_check": "Last check:",
+    "page.edit_feed.next_check": "Next check:",
+    "page.edit_feed.websub_hub": "WebSub Hub:",
+    "page.edit_feed.websub_lease": "updates pushed until %s",
     "page.edit_feed.last_modified_header": "LastModified header:",
     "page.edit_feed.etag_header": "ETag header:",
     "page.edit_feed.no_header": "None",
     "page.edit_feed.last_parsing_error": "Last Parsing Error",
+    "page.edit_feed.fetch_history": "Fetch History",
+    "page.edit_feed.fetch_history.date": "Date",
+    "page.edit_feed.fetch_history.status": "HTTP Status",
+    "page.edit_feed.fetch_history.duration": "Duration",
+    "page.edit_feed.fetch_history.size": "Size (bytes)",
+    "page.edit_feed.fetch_history.entries": "Entries",
+    "page.edit_feed.fetch_history.entries_count": "%d new, %d updated",
+    "page.edit_feed.fetch_history.error": "Error",
+    "page.edit_feed.fetch_history.not_modified": "not modified",
+    "page.edit_feed.fetch_history.moved": "Moved from %s to %s",
     "page.entry.attachments": "Attachments",
+    "page.entry.duplicates": "Also published in",
+    "page.entry.tags": "Tags",
+    "page.entry_revisions.title": "Article changes",
+    "page.entry_revisions.changed_at": "Changed",
+    "page.entry_revisions.same_content": "The content has not changed.",
     "page.keyboard_shortcuts.title": "Keyboard Shortcuts",
     "page.keyboard_shortcuts.subtitle.sections": "Sections Navigation",
     "page.keyboard_shortcuts.subtitle.items": "Items Navigation",
@@ -460,11 +673,20 @@ var translations = map[string]string{
     "page.sessions.table.actions": "Actions",
     "page.sessions.table.current_session": "Current Session",
     "alert.no_bookmark": "There is no bookmark at the moment.",
+    "alert.no_tag": "There is no tag at the moment.",
+    "alert.no_tag_entry": "There is no article with this tag.",
     "alert.no_category": "There is no category.",
     "alert.no_category_entry": "There are no articles in this category.",
     "alert.no_feed_entry": "There are no articles for this feed.",
     "alert.no_feed": "You don't have any subscriptions.",
     "alert.no_history": "There is no history at the moment.",
+    "alert.no_revision": "There are no previous versions of this article.",
+    "alert.no_rule": "There is no rule.",
+    "alert.no_site_rule": "There is no site rule.",
+    "alert.rule_applied": [
+        "The rule matched %d article.",
+        "The rule matched %d articles."
+    ],
     "alert.feed_error": "There is a problem with this feed",
     "alert.no_search_result": "There are no results for this search.",
     "alert.no_unread_entry": "There are no unread articles.",
@@ -489,6 +711,23 @@ var translations = map[string]string{
     "error.empty_file": "This file is empty.",
     "error.bad_credentials": "Invalid username or password.",
     "error.fields_mandatory": "All fields are mandatory.",
+    "error.invalid_proxy_url": "Invalid proxy URL, only http, https and socks5 proxies are supported.",
+    "error.invalid_duplicate_policy": "Invalid policy for duplicate entries.",
+    "error.invalid_filter_rules": "Invalid filter rules.",
+    "error.rule_condition_required": "The rule must have at least one condition.",
+    "error.rule_action_required": "The rule must have at least one action.",
+    "error.rule_condition_not_found": "The selected feed or category does not exist.",
+    "error.invalid_rule_age": "The age of the articles must be a positive number of days.",
+    "error.invalid_rule_pattern": "Invalid regular expression: %s",
+    "error.unable_to_create_rule": "Unable to create this rule.",
+    "error.unable_to_update_rule": "Unable to update this rule.",
+    "error.domain_required": "The domain is mandatory.",
+    "error.invalid_domain": "Invalid domain %q, only the host name is expected (for example: example.org).",
+    "error.site_rule_already_exists": "A site rule already exists for this domain.",
+    "error.unable_to_create_site_rule": "Unable to create this site rule.",
+    "error.unable_to_update_site_rule": "Unable to update this site rule.",
+    "error.invalid_headers": "Invalid custom headers or cookie.",
+    "error.encryption_key_missing": "Custom headers and cookies cannot be saved because no encryption key is configured (ENCRYPTION_KEY).",
     "error.title_required": "The title is mandatory.",
     "error.different_passwords": "Passwords are not the same.",
     "error.password_min_length": "The password must have at least 6 characters.",
@@ -496,15 +735,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "The URL and the category are mandatory.",
     "error.user_mandatory_fields": "The username is mandatory.",
     "form.feed.label.title": "Title",
+    "form.entry.label.tags": "Tags (separated by commas)",
     "form.feed.label.site_url": "Site URL",
     "form.feed.label.feed_url": "Feed URL",
     "form.feed.label.category": "Category",
     "form.feed.label.crawler": "Fetch original content",
+    "form.feed.label.mark_unread_on_update": "Mark updated articles as unread",
+    "form.feed.label.keep_tracking_parameters": "Keep the tracking parameters of links (utm_source, fbclid...)",
+    "form.feed.label.resolve_final_url": "Follow the redirections of article links to store their final URL (FeedBurner, link shorteners...)",
+    "form.feed.label.disabled": "Do not refresh this feed",
     "form.feed.label.feed_username": "Feed Username",
     "form.feed.label.feed_password": "Feed Password",
     "form.feed.label.user_agent": "Override Default User Agent",
+    "form.feed.label.proxy_url": "Proxy URL (http, https or socks5)",
+    "form.feed.label.headers": "Custom Headers (one \"Name: Value\" per line)",
+    "form.feed.label.cookie": "Cookie",
     "form.feed.label.scraper_rules": "Scraper Rules",
     "form.feed.label.rewrite_rules": "Rewrite Rules",
+    "form.feed.label.keep_rules": "Keep only the articles matching these rules",
+    "form.feed.label.block_rules": "Block the articles matching these rules",
+    "form.feed.help.filter_rules": "One regular expression per line, optionally prefixed by the field: title, content, author, url or category.",
+    "form.feed.help.scraper_rules": "CSS selectors of the content, optionally followed by \"!\" and the CSS selectors of the elements to remove.",
+    "form.feed.help.rewrite_rules": "Rules separated by commas: add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"replacement\"), replace_title(\"regex\"|\"replacement\"), remove(\"CSS selector\") and base64_decode(\"CSS selector\").",
+    "form.feed.help.undecryptable_headers": "The stored headers or cookie cannot be decrypted with the current encryption key, they are not sent and will be replaced by the values of this form when it is saved.",
+    "form.feed.label.filter_action": "Blocked articles",
+    "form.feed.select.filter_drop": "Ignore them",
+    "form.feed.select.filter_read": "Store them as read",
+    "form.rule.label.title": "Title",
+    "form.rule.section.conditions": "Conditions",
+    "form.rule.section.actions": "Actions",
+    "form.rule.label.feed": "Feed",
+    "form.rule.select.any_feed": "Any feed",
+    "form.rule.label.category": "Category",
+    "form.rule.select.any_category": "Any category",
+    "form.rule.label.title_pattern": "Title matches",
+    "form.rule.label.content_pattern": "Content matches",
+    "form.rule.label.author_pattern": "Author matches",
+    "form.rule.label.older_than": "Published more than N days ago",
+    "form.rule.help.conditions": "The articles must satisfy all the conditions, the empty ones are ignored. The patterns are regular expressions.",
+    "form.rule.label.mark_as_read": "Mark as read",
+    "form.rule.label.star": "Star",
+    "form.rule.label.send_to_integrations": "Send to the third-party services",
+    "form.rule.label.tag": "Add the tag",
+    "form.site_rule.label.domain": "Domain",
+    "form.site_rule.label.disabled": "Disable the predefined rules for this domain",
+    "form.site_rule.label.global": "Share this rule with all users",
     "form.category.label.title": "Title",
     "form.user.label.username": "Username",
     "form.user.label.password": "Password",
@@ -517,6 +792,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Older entries first",
     "form.prefs.select.recent_first": "Recent entries first",
     "form.prefs.label.keyboard_shortcuts": "Enable keyboard shortcuts",
+    "form.prefs.label.duplicate_policy": "Articles already published by another feed",
+    "form.prefs.select.duplicate_none": "Keep them unread",
+    "form.prefs.select.duplicate_read": "Mark them as read",
+    "form.prefs.select.duplicate_group": "Group them under the first article",
+    "form.prefs.label.duplicate_match_title": "Also detect duplicate articles by their title",
+    "form.prefs.label.mark_read_on_media_completion": "Mark the articles with a podcast or a video as read only once the playback is finished",
     "form.import.label.file": "OPML file",
     "form.integration.fever_activate": "Activate Fever API",
     "form.integration.fever_username": "Fever Username",
@@ -596,6 +877,7 @@ var translations = map[string]string{
     "menu.history": "Historial",
     "menu.feeds": "Fuentes",
     "menu.categories": "Categorias",
+    "menu.tags": "Etiquetas",
     "menu.settings": "Configuración",
     "menu.logout": "Cerrar sesión",
     "menu.preferences": "Preferencias",
@@ -603,14 +885,20 @@ var translations = map[string]string{
     "menu.sessions": "Sesiones",
     "menu.users": "Usuarios",
     "menu.about": "Acerca de",
+    "menu.rules": "Reglas",
     "menu.export": "Exportar",
     "menu.import": "Importar",
     "menu.create_category": "Crear una categoría",
+    "menu.create_rule": "Crear una regla",
+    "menu.site_rules": "Reglas de sitios",
+    "menu.create_site_rule": "Crear una regla de sitio",
     "menu.mark_page_as_read": "Marcar esta pagína como leída",
     "menu.mark_all_as_read": "Marcar todos como leídos",
     "menu.mark_all_as_read_wip": "Operación en progreso...",
     "menu.show_all_entries": "Mostrar todas las entradas",
     "menu.show_only_unread_entries": "Mostrar solo las entradas no leídas",
+    "menu.show_only_short_reads": "Mostrar solo lecturas cortas (menos de 5 minutos)",
+    "menu.show_all_reading_times": "Mostrar artículos de cualquier tiempo de lectura",
     "menu.refresh_feed": "Refrescar",
     "menu.refresh_all_feeds": "Refrescar todas las fuentes en el fondo",
     "menu.edit_feed": "Editar",
@@ -638,14 +926,59 @@ var translations = map[string]string{
     "entry.original.label": "Original",
     "entry.comments.label": "Comentarios",
     "entry.comments.title": "Ver comentarios",
+    "entry.estimated_reading_time": [
+        "%d minuto de lectura",
+        "%d minutos de lectura"
+    ],
+    "entry.revisions.label": "Cambios",
+    "entry.podcast.season": "Temporada %d",
+    "entry.podcast.episode": "Episodio %d",
+    "entry.podcast.explicit": "Explícito",
+    "entry.media.playback_speed": "Velocidad de reproducción",
+    "entry.revisions.title": "Ver los cambios realizados en este artículo",
+    "entry.updated": "actualizado",
     "page.unread.title": "No leídos",
     "page.starred.title": "Marcadores",
     "page.categories.title": "Categorias",
+    "page.tags.title": "Etiquetas",
+    "page.tags.entry_count": [
+        "Hay %d artículo.",
+        "Hay %d artículos."
+    ],
     "page.categories.no_feed": "No fuente.",
     "page.categories.feed_count": [
         "Hay %d fuente.",
         "Hay %d fuentes."
     ],
+    "page.rules.title": "Reglas",
+    "page.new_rule.title": "Nueva regla",
+    "page.edit_rule.title": "Editar regla: %s",
+    "page.rules.feed": "Fuente: %s",
+    "page.rules.category": "Categoría: %s",
+    "page.rules.title_pattern": "Título: %s",
+    "page.rules.content_pattern": "Contenido: %s",
+    "page.rules.author_pattern": "Autor: %s",
+    "page.rules.older_than": [
+        "Más antiguo que %d día",
+        "Más antiguo que %d días"
+    ],
+    "page.rules.match_count": [
+        "%d artículo coincidente",
+        "%d artículos coincidentes"
+    ],
+    "page.rules.apply": "Aplicar a los artículos existentes",
+    "page.site_rules.title": "Reglas de sitios",
+    "page.new_site_rule.title": "Nueva regla de sitio",
+    "page.edit_site_rule.title": "Editar regla de sitio: %s",
+    "page.site_rules.help": "Las reglas de sitios se usan cuando una fuente no define sus propias reglas de extracción o de reescritura. Sus reglas tienen prioridad sobre las compartidas con todos los usuarios. Una regla desactivada desactiva las reglas predefinidas para el dominio.",
+    "page.site_rules.global": "Compartida con todos los usuarios",
+    "page.site_rules.private": "Privada",
+    "page.site_rules.disabled": "Desactivada",
+    "page.site_rules.scraper_rules": "Reglas de extracción: %s",
+    "page.site_rules.rewrite_rules": "Reglas de reescritura: %s",
+    "page.site_rules.builtin": "Reglas integradas",
+    "page.site_rules.override": "Reemplazar",
+    "page.site_rules.global_help": "Esta regla se comparte con todos los usuarios.",
     "page.new_category.title": "Nueva categoría",
     "page.new_user.title": "Nuevo usario",
     "page.edit_category.title": "Editar categoría: %s",
@@ -656,6 +989,8 @@ var translations = map[string]string{
         "%d error",
         "%d errores"
     ],
+    "page.feeds.next_retry": "Próximo intento:",
+    "page.feeds.disabled": "Desactivado",
     "page.history.title": "Historial",
     "page.import.title": "Importar",
     "page.search.title": "Resultados de la búsqueda",
@@ -673,11 +1008,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Elegir una suscripción",
     "page.edit_feed.title": "Editar fuente: %s",
     "page.edit_feed.last_check": "Última verificación:",
+    "page.edit_feed.next_check": "Próxima verificación:",
+    "page.edit_feed.websub_hub": "Hub WebSub:",
+    "page.edit_feed.websub_lease": "actualizaciones enviadas hasta %s",
     "page.edit_feed.last_modified_header": "Cabecera de LastModified:",
     "page.edit_feed.etag_header": "Cabecera de ETag:",
     "page.edit_feed.no_header": "Sin cabecera",
     "page.edit_feed.last_parsing_error": "Último error de análisis",
+    "page.edit_feed.fetch_history": "Historial de descargas",
+    "page.edit_feed.fetch_history.date": "Fecha",
+    "page.edit_feed.fetch_history.status": "Estado HTTP",
+    "page.edit_feed.fetch_history.duration": "Duración",
+    "page.edit_feed.fetch_history.size": "Tamaño (bytes)",
+    "page.edit_feed.fetch_history.entries": "Artículos",
+    "page.edit_feed.fetch_history.entries_count": "%d nuevos, %d actualizados",
+    "page.edit_feed.fetch_history.error": "Error",
+    "page.edit_feed.fetch_history.not_modified": "sin cambios",
+    "page.edit_feed.fetch_history.moved": "Movido de %s a %s",
     "page.entry.attachments": "Archivos adjuntos",
+    "page.entry.duplicates": "También publicado en",
+    "page.entry.tags": "Etiquetas",
+    "page.entry_revisions.title": "Cambios del artículo",
+    "page.entry_revisions.changed_at": "Modificado",
+    "page.entry_revisions.same_content": "El contenido no ha cambiado.",
     "page.keyboard_shortcuts.title": "Atajos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegación de secciones",
     "page.keyboard_shortcuts.subtitle.items": "Navegación de artículos",
@@ -735,11 +1088,20 @@ var translations = map[string]string{
     "page.sessions.table.actions": "Acciones",
     "page.sessions.table.current_session": "Sesión actual",
     "alert.no_bookmark": "No hay marcador en este momento.",
+    "alert.no_tag": "No hay etiquetas en este momento.",
+    "alert.no_tag_entry": "No hay artículos con esta etiqueta.",
     "alert.no_category": "No hay categoría.",
     "alert.no_category_entry": "No hay artículos en esta categoria.",
     "alert.no_feed_entry": "No hay artículos para esta fuente.",
     "alert.no_feed": "No tienes suscripciones.",
     "alert.no_history": "No hay historial en este momento.",
+    "alert.no_revision": "No hay versiones anteriores de este artículo.",
+    "alert.no_rule": "No hay ninguna regla.",
+    "alert.no_site_rule": "No hay ninguna regla de sitio.",
+    "alert.rule_applied": [
+        "La regla coincidió con %d artículo.",
+        "La regla coincidió con %d artículos."
+    ],
     "alert.feed_error": "Hay un problema con esta fuente.",
     "alert.no_search_result": "No hay resultados para esta búsqueda.",
     "alert.no_unread_entry": "No hay artículos sin leer.",
@@ -764,6 +1126,23 @@ var translations = map[string]string{
     "error.empty_file": "Este archivo está vacío.",
     "error.bad_credentials": "Usuario o contraseña no válido.",
     "error.fields_mandatory": "Todos los campos son obligatorios.",
+    "error.invalid_proxy_url": "URL del proxy no válida, solo se admiten proxies http, https y socks5.",
+    "error.invalid_duplicate_policy": "Política no válida para los artículos duplicados.",
+    "error.invalid_filter_rules": "Reglas de filtro no válidas.",
+    "error.rule_condition_required": "La regla debe tener al menos una condición.",
+    "error.rule_action_required": "La regla debe tener al menos una acción.",
+    "error.rule_condition_not_found": "La fuente o categoría seleccionada no existe.",
+    "error.invalid_rule_age": "La antigüedad de los artículos debe ser un número positivo de días.",
+    "error.invalid_rule_pattern": "Expresión regular no válida: %s",
+    "error.unable_to_create_rule": "No se puede crear esta regla.",
+    "error.unable_to_update_rule": "No se puede actualizar esta regla.",
+    "error.domain_required": "El dominio es obligatorio.",
+    "error.invalid_domain": "Dominio %q no válido, solo se espera el nombre del host (por ejemplo: example.org).",
+    "error.site_rule_already_exists": "Ya existe una regla de sitio para este dominio.",
+    "error.unable_to_create_site_rule": "No se puede crear esta regla de sitio.",
+    "error.unable_to_update_site_rule": "No se puede actualizar esta regla de sitio.",
+    "error.invalid_headers": "Encabezados personalizados o cookie no válidos.",
+    "error.encryption_key_missing": "Los encabezados personalizados y las cookies no se pueden guardar porque no hay ninguna clave de cifrado configurada (ENCRYPTION_KEY).",
     "error.title_required": "El título es obligatorio.",
     "error.different_passwords": "Las contraseñas no son las mismas.",
     "error.password_min_length": "La contraseña debería tener al menos 6 caracteres.",
@@ -771,15 +1150,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "Los campos de URL y categoría son obligatorios.",
     "error.user_mandatory_fields": "El nombre de usuario es obligatorio.",
     "form.feed.label.title": "Título",
+    "form.entry.label.tags": "Etiquetas (separadas por comas)",
     "form.feed.label.site_url": "URL del sitio",
     "form.feed.label.feed_url": "URL de la fuente",
     "form.feed.label.category": "Categoría",
     "form.feed.label.crawler": "Obtener contento original",
+    "form.feed.label.mark_unread_on_update": "Marcar los artículos actualizados como no leídos",
+    "form.feed.label.keep_tracking_parameters": "Conservar los parámetros de seguimiento de los enlaces (utm_source, fbclid...)",
+    "form.feed.label.resolve_final_url": "Seguir las redirecciones de los enlaces de los artículos para guardar su URL final (FeedBurner, acortadores de enlaces...)",
+    "form.feed.label.disabled": "No actualizar esta fuente",
     "form.feed.label.feed_username": "Nombre de usuario de fuente",
     "form.feed.label.feed_password": "Contraseña de fuente",
     "form.feed.label.user_agent": "Invalidar el agente de usuario predeterminado",
+    "form.feed.label.proxy_url": "URL del proxy (http, https o socks5)",
+    "form.feed.label.headers": "Encabezados personalizados (un \"Nombre: Valor\" por línea)",
+    "form.feed.label.cookie": "Cookie",
     "form.feed.label.scraper_rules": "Reglas de raspador",
     "form.feed.label.rewrite_rules": "Reglas de reescribir",
+    "form.feed.label.keep_rules": "Conservar solo los artículos que coincidan con estas reglas",
+    "form.feed.label.block_rules": "Bloquear los artículos que coincidan con estas reglas",
+    "form.feed.help.filter_rules": "Una expresión regular por línea, opcionalmente precedida por el campo: title, content, author, url o category.",
+    "form.feed.help.scraper_rules": "Selectores CSS del contenido, opcionalmente seguidos de \"!\" y de los selectores CSS de los elementos a eliminar.",
+    "form.feed.help.rewrite_rules": "Reglas separadas por comas: add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"reemplazo\"), replace_title(\"regex\"|\"reemplazo\"), remove(\"selector CSS\") y base64_decode(\"selector CSS\").",
+    "form.feed.help.undecryptable_headers": "Los encabezados o la cookie guardados no se pueden descifrar con la clave de cifrado actual, no se envían y se reemplazarán por los valores de este formulario al guardarlo.",
+    "form.feed.label.filter_action": "Artículos bloqueados",
+    "form.feed.select.filter_drop": "Ignorarlos",
+    "form.feed.select.filter_read": "Guardarlos como leídos",
+    "form.rule.label.title": "Título",
+    "form.rule.section.conditions": "Condiciones",
+    "form.rule.section.actions": "Acciones",
+    "form.rule.label.feed": "Fuente",
+    "form.rule.select.any_feed": "Cualquier fuente",
+    "form.rule.label.category": "Categoría",
+    "form.rule.select.any_category": "Cualquier categoría",
+    "form.rule.label.title_pattern": "El título coincide con",
+    "form.rule.label.content_pattern": "El contenido coincide con",
+    "form.rule.label.author_pattern": "El autor coincide con",
+    "form.rule.label.older_than": "Publicado hace más de N días",
+    "form.rule.help.conditions": "Los artículos deben cumplir todas las condiciones, las vacías se ignoran. Los patrones son expresiones regulares.",
+    "form.rule.label.mark_as_read": "Marcar como leído",
+    "form.rule.label.star": "Marcar con estrella",
+    "form.rule.label.send_to_integrations": "Enviar a los servicios de terceros",
+    "form.rule.label.tag": "Añadir la etiqueta",
+    "form.site_rule.label.domain": "Dominio",
+    "form.site_rule.label.disabled": "Desactivar las reglas predefinidas para este dominio",
+    "form.site_rule.label.global": "Compartir esta regla con todos los usuarios",
     "form.category.label.title": "Título",
     "form.user.label.username": "Nombre de usuario",
     "form.user.label.password": "Contraseña",
@@ -792,6 +1207,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Entradas más viejas primero",
     "form.prefs.select.recent_first": "Entradas recientes primero",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atajos de teclado",
+    "form.prefs.label.duplicate_policy": "Artículos ya publicados por otra fuente",
+    "form.prefs.select.duplicate_none": "Mantenerlos como no leídos",
+    "form.prefs.select.duplicate_read": "Marcarlos como leídos",
+    "form.prefs.select.duplicate_group": "Agruparlos bajo el primer artículo",
+    "form.prefs.label.duplicate_match_title": "Detectar también los artículos duplicados por su título",
+    "form.prefs.label.mark_read_on_media_completion": "Marcar los artículos con un podcast o un vídeo como leídos solo al terminar la reproducción",
     "form.import.label.file": "Archivo OPML",
     "form.integration.fever_activate": "Activar API de Fever",
     "form.integration.fever_username": "Nombre de usuario de Fever",
@@ -871,6 +1292,7 @@ var translations = map[string]string{
     "menu.history": "Historique",
     "menu.feeds": "Abonnements",
     "menu.categories": "Catégories",
+    "menu.tags": "Étiquettes",
     "menu.settings": "Réglages",
     "menu.logout": "Se déconnecter",
     "menu.preferences": "Préférences",
@@ -878,14 +1300,20 @@ var translations = map[string]string{
     "menu.sessions": "Sessions",
     "menu.users": "Utilisateurs",
     "menu.about": "A propos",
+    "menu.rules": "Règles",
     "menu.export": "Export",
     "menu.import": "Import",
     "menu.create_category": "Créer une catégorie",
+    "menu.create_rule": "Créer une règle",
+    "menu.site_rules": "Règles des sites",
+    "menu.create_site_rule": "Créer une règle de site",
     "menu.mark_page_as_read": "Marquer cette page comme lu",
     "menu.mark_all_as_read": "Tout marquer comme lu",
     "menu.mark_all_as_read_wip": "Opération en cours...",
     "menu.show_all_entries": "Afficher tous les articles",
     "menu.show_only_unread_entries": "Afficher uniquement les articles non lus",
+    "menu.show_only_short_reads": "Afficher uniquement les lectures courtes (moins de 5 minutes)",
+    "menu.show_all_reading_times": "Afficher les articles de toute durée de lecture",
     "menu.refresh_feed": "Actualiser",
     "menu.refresh_all_feeds": "Actualiser les abonnements en arrière-plan",
     "menu.edit_feed": "Modifier",
@@ -913,14 +1341,59 @@ var translations = map[string]string{
     "entry.original.label": "Original",
     "entry.comments.label": "Commentaires",
     "entry.comments.title": "Voir les commentaires",
+    "entry.estimated_reading_time": [
+        "%d minute de lecture",
+        "%d minutes de lecture"
+    ],
+    "entry.revisions.label": "Modifications",
+    "entry.podcast.season": "Saison %d",
+    "entry.podcast.episode": "Épisode %d",
+    "entry.podcast.explicit": "Explicite",
+    "entry.media.playback_speed": "Vitesse de lecture",
+    "entry.revisions.title": "Voir les modifications de cet article",
+    "entry.updated": "mis à jour",
     "page.unread.title": "Non lus",
     "page.starred.title": "Favoris",
     "page.categories.title": "Catégories",
+    "page.tags.title": "Étiquettes",
+    "page.tags.entry_count": [
+        "Il y a %d article.",
+        "Il y a %d articles."
+    ],
     "page.categories.no_feed": "Aucun abonnement.",
     "page.categories.feed_count": [
         "Il y a %d abonnement.",
         "Il y a %d abonnements."
     ],
+    "page.rules.title": "Règles",
+    "page.new_rule.title": "Nouvelle règle",
+    "page.edit_rule.title": "Modifier la règle : %s",
+    "page.rules.feed": "Abonnement : %s",
+    "page.rules.category": "Catégorie : %s",
+    "page.rules.title_pattern": "Titre : %s",
+    "page.rules.content_pattern": "Contenu : %s",
+    "page.rules.author_pattern": "Auteur : %s",
+    "page.rules.older_than": [
+        "Plus ancien que %d jour",
+        "Plus ancien que %d jours"
+    ],
+    "page.rules.match_count": [
+        "%d article correspondant",
+        "%d articles correspondants"
+    ],
+    "page.rules.apply": "Appliquer aux articles existants",
+    "page.site_rules.title": "Règles des sites",
+    "page.new_site_rule.title": "Nouvelle règle de site",
+    "page.edit_site_rule.title": "Modifier la règle de site : %s",
+    "page.site_rules.help": "Les règles des sites sont utilisées lorsqu'un abonnement ne définit pas ses propres règles d'extraction ou de réécriture. Vos règles ont priorité sur celles partagées avec tous les utilisateurs. Une règle désactivée supprime les règles prédéfinies pour le domaine.",
+    "page.site_rules.global": "Partagée avec tous les utilisateurs",
+    "page.site_rules.private": "Privée",
+    "page.site_rules.disabled": "Désactivée",
+    "page.site_rules.scraper_rules": "Règles d'extraction : %s",
+    "page.site_rules.rewrite_rules": "Règles de réécriture : %s",
+    "page.site_rules.builtin": "Règles intégrées",
+    "page.site_rules.override": "Remplacer",
+    "page.site_rules.global_help": "Cette règle est partagée avec tous les utilisateurs.",
     "page.new_category.title": "Nouvelle catégorie",
     "page.new_user.title": "Nouvel Utilisateur",
     "page.edit_category.title": "Modification de la catégorie : %s",
@@ -931,6 +1404,8 @@ var translations = map[string]string{
         "%d erreur",
         "%d erreurs"
     ],
+    "page.feeds.next_retry": "Prochain essai :",
+    "page.feeds.disabled": "Désactivé",
     "page.history.title": "Historique",
     "page.import.title": "Importation",
     "page.search.title": "Résultats de la recherche",
@@ -948,11 +1423,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Choisissez un abonnement",
     "page.edit_feed.title": "Modification de l'abonnement : %s",
     "page.edit_feed.last_check": "Dernière vérification :",
+    "page.edit_feed.next_check": "Prochaine vérification :",
+    "page.edit_feed.websub_hub": "Hub WebSub :",
+    "page.edit_feed.websub_lease": "mises à jour poussées jusqu'au %s",
     "page.edit_feed.last_modified_header": "En-tête LastModified :",
     "page.edit_feed.etag_header": "En-tête ETag :",
     "page.edit_feed.no_header": "Aucune",
     "page.edit_feed.last_parsing_error": "Dernière erreur d'analyse",
+    "page.edit_feed.fetch_history": "Historique des récupérations",
+    "page.edit_feed.fetch_history.date": "Date",
+    "page.edit_feed.fetch_history.status": "Statut HTTP",
+    "page.edit_feed.fetch_history.duration": "Durée",
+    "page.edit_feed.fetch_history.size": "Taille (octets)",
+    "page.edit_feed.fetch_history.entries": "Articles",
+    "page.edit_feed.fetch_history.entries_count": "%d nouveaux, %d mis à jour",
+    "page.edit_feed.fetch_history.error": "Erreur",
+    "page.edit_feed.fetch_history.not_modified": "non modifié",
+    "page.edit_feed.fetch_history.moved": "Déplacé de %s vers %s",
     "page.entry.attachments": "Pièces Jointes",
+    "page.entry.duplicates": "Également publié dans",
+    "page.entry.tags": "Étiquettes",
+    "page.entry_revisions.title": "Modifications de l'article",
+    "page.entry_revisions.changed_at": "Modifié",
+    "page.entry_revisions.same_content": "Le contenu n'a pas changé.",
     "page.keyboard_shortcuts.title": "Raccourcis clavier",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguation entre les sections",
     "page.keyboard_shortcuts.subtitle.items": "Naviguation entre les éléments",
@@ -1010,11 +1503,20 @@ var translations = map[string]string{
     "page.sessions.table.actions": "Actions",
     "page.sessions.table.current_session": "Session actuelle",
     "alert.no_bookmark": "Il n'y a aucun favoris pour le moment.",
+    "alert.no_tag": "Il n'y a aucune étiquette pour le moment.",
+    "alert.no_tag_entry": "Il n'y a aucun article avec cette étiquette.",
     "alert.no_category": "Il n'y a aucune catégorie.",
     "alert.no_category_entry": "Il n'y a aucun article dans cette catégorie.",
     "alert.no_feed_entry": "Il n'y a aucun article pour cet abonnement.",
     "alert.no_feed": "Vous n'avez aucun abonnement.",
     "alert.no_history": "Il n'y a aucun historique pour le moment.",
+    "alert.no_revision": "Il n'y a aucune version précédente de cet article.",
+    "alert.no_rule": "Il n'y a aucune règle.",
+    "alert.no_site_rule": "Il n'y a aucune règle de site.",
+    "alert.rule_applied": [
+        "La règle correspond à %d article.",
+        "La règle correspond à %d articles."
+    ],
     "alert.feed_error": "Il y a un problème avec cet abonnement",
     "alert.no_search_result": "Il n'y a aucun résultat pour cette recherche.",
     "alert.no_unread_entry": "Il n'y a rien de nouveau à lire.",
@@ -1039,6 +1541,23 @@ var translations = map[string]string{
     "error.empty_file": "Ce fichier est vide.",
     "error.bad_credentials": "Mauvais identifiant ou mot de passe.",
     "error.fields_mandatory": "Tous les champs sont obligatoire.",
+    "error.invalid_proxy_url": "URL du proxy invalide, seuls les proxys http, https et socks5 sont supportés.",
+    "error.invalid_duplicate_policy": "Règle invalide pour les articles en double.",
+    "error.invalid_filter_rules": "Règles de filtrage invalides.",
+    "error.rule_condition_required": "La règle doit avoir au moins une condition.",
+    "error.rule_action_required": "La règle doit avoir au moins une action.",
+    "error.rule_condition_not_found": "L'abonnement ou la catégorie sélectionné n'existe pas.",
+    "error.invalid_rule_age": "L'âge des articles doit être un nombre positif de jours.",
+    "error.invalid_rule_pattern": "Expression régulière invalide : %s",
+    "error.unable_to_create_rule": "Impossible de créer cette règle.",
+    "error.unable_to_update_rule": "Impossible de mettre à jour cette règle.",
+    "error.domain_required": "Le domaine est obligatoire.",
+    "error.invalid_domain": "Domaine %q invalide, seul le nom d'hôte est attendu (par exemple : example.org).",
+    "error.site_rule_already_exists": "Une règle de site existe déjà pour ce domaine.",
+    "error.unable_to_create_site_rule": "Impossible de créer cette règle de site.",
+    "error.unable_to_update_site_rule": "Impossible de mettre à jour cette règle de site.",
+    "error.invalid_headers": "En-têtes personnalisés ou cookie invalides.",
+    "error.encryption_key_missing": "Les en-têtes personnalisés et les cookies ne peuvent pas être enregistrés car aucune clé de chiffrement n'est configurée (ENCRYPTION_KEY).",
     "error.title_required": "Le titre est obligatoire.",
     "error.different_passwords": "Les mots de passe ne sont pas les mêmes.",
     "error.password_min_length": "Vous devez utiliser au moins 6 caractères pour le mot de passe.",
@@ -1046,15 +1565,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "L'URL et la catégorie sont obligatoire.",
     "error.user_mandatory_fields": "Le nom d'utilisateur est obligatoire.",
     "form.feed.label.title": "Titre",
+    "form.entry.label.tags": "Étiquettes (séparées par des virgules)",
     "form.feed.label.site_url": "URL du site web",
     "form.feed.label.feed_url": "URL du flux",
     "form.feed.label.category": "Catégorie",
     "form.feed.label.crawler": "Récupérer le contenu original",
+    "form.feed.label.mark_unread_on_update": "Marquer les articles mis à jour comme non lus",
+    "form.feed.label.keep_tracking_parameters": "Conserver les paramètres de suivi des liens (utm_source, fbclid...)",
+    "form.feed.label.resolve_final_url": "Suivre les redirections des liens des articles pour enregistrer leur URL finale (FeedBurner, raccourcisseurs de liens...)",
+    "form.feed.label.disabled": "Ne pas actualiser cet abonnement",
     "form.feed.label.feed_username": "Nom d'utilisateur du flux",
     "form.feed.label.feed_password": "Mot de passe du flux",
     "form.feed.label.user_agent": "Remplacer l'agent utilisateur par défaut",
+    "form.feed.label.proxy_url": "URL du proxy (http, https ou socks5)",
+    "form.feed.label.headers": "En-têtes personnalisés (un « Nom: Valeur » par ligne)",
+    "form.feed.label.cookie": "Cookie",
     "form.feed.label.scraper_rules": "Règles pour récupérer le contenu original",
     "form.feed.label.rewrite_rules": "Règles de réécriture",
+    "form.feed.label.keep_rules": "Garder uniquement les articles correspondant à ces règles",
+    "form.feed.label.block_rules": "Bloquer les articles correspondant à ces règles",
+    "form.feed.help.filter_rules": "Une expression régulière par ligne, éventuellement préfixée par le champ : title, content, author, url ou category.",
+    "form.feed.help.scraper_rules": "Sélecteurs CSS du contenu, suivis éventuellement de « ! » et des sélecteurs CSS des éléments à supprimer.",
+    "form.feed.help.rewrite_rules": "Règles séparées par des virgules : add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"remplacement\"), replace_title(\"regex\"|\"remplacement\"), remove(\"sélecteur CSS\") et base64_decode(\"sélecteur CSS\").",
+    "form.feed.help.undecryptable_headers": "Les en-têtes ou le cookie enregistrés ne peuvent pas être déchiffrés avec la clé de chiffrement actuelle, ils ne sont pas envoyés et seront remplacés par les valeurs de ce formulaire lors de son enregistrement.",
+    "form.feed.label.filter_action": "Articles bloqués",
+    "form.feed.select.filter_drop": "Les ignorer",
+    "form.feed.select.filter_read": "Les enregistrer comme lus",
+    "form.rule.label.title": "Titre",
+    "form.rule.section.conditions": "Conditions",
+    "form.rule.section.actions": "Actions",
+    "form.rule.label.feed": "Abonnement",
+    "form.rule.select.any_feed": "N'importe quel abonnement",
+    "form.rule.label.category": "Catégorie",
+    "form.rule.select.any_category": "N'importe quelle catégorie",
+    "form.rule.label.title_pattern": "Le titre correspond à",
+    "form.rule.label.content_pattern": "Le contenu correspond à",
+    "form.rule.label.author_pattern": "L'auteur correspond à",
+    "form.rule.label.older_than": "Publié il y a plus de N jours",
+    "form.rule.help.conditions": "Les articles doivent satisfaire toutes les conditions, celles laissées vides sont ignorées. Les motifs sont des expressions régulières.",
+    "form.rule.label.mark_as_read": "Marquer comme lu",
+    "form.rule.label.star": "Ajouter aux favoris",
+    "form.rule.label.send_to_integrations": "Envoyer aux services tiers",
+    "form.rule.label.tag": "Ajouter l'étiquette",
+    "form.site_rule.label.domain": "Domaine",
+    "form.site_rule.label.disabled": "Désactiver les règles prédéfinies pour ce domaine",
+    "form.site_rule.label.global": "Partager cette règle avec tous les utilisateurs",
     "form.category.label.title": "Titre",
     "form.user.label.username": "Nom d'utilisateur",
     "form.user.label.password": "Mot de passe",
@@ -1067,6 +1622,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Ancien éléments en premier",
     "form.prefs.select.recent_first": "Éléments récents en premier",
     "form.prefs.label.keyboard_shortcuts": "Activer les raccourcis clavier",
+    "form.prefs.label.duplicate_policy": "Articles déjà publiés par un autre abonnement",
+    "form.prefs.select.duplicate_none": "Les garder non lus",
+    "form.prefs.select.duplicate_read": "Les marquer comme lus",
+    "form.prefs.select.duplicate_group": "Les regrouper sous le premier article",
+    "form.prefs.label.duplicate_match_title": "Détecter aussi les articles en double par leur titre",
+    "form.prefs.label.mark_read_on_media_completion": "Marquer les articles avec un podcast ou une vidéo comme lus seulement à la fin de la lecture",
     "form.import.label.file": "Fichier OPML",
     "form.integration.fever_activate": "Activer l'API de Fever",
     "form.integration.fever_username": "Nom d'utilisateur pour l'API de Fever",
@@ -1140,7 +1701,8 @@ var translations = map[string]string{
     "Website unreachable, the request timed out after %d seconds": "Site web injoignable, la requête à échouée après %d secondes",
     "You are not authorized to access this resource (invalid username/password)": "Vous n'êtes pas autorisé à accéder à cette ressource (nom d'utilisateur / mot de passe incorrect)",
     "Unable to fetch this resource (Status Code = %d)": "Impossible de récupérer cette ressource (code=%d)",
-    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux"
+    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux",
+    "This feed has been removed permanently (410), it will not be refreshed anymore": "Cet abonnement a été supprimé définitivement (410), il ne sera plus actualisé"
 }
 `,
 	"it_IT": `{
@@ -1166,6 +1728,7 @@ var translations = map[string]string{
     "menu.history": "Cronologia",
     "menu.feeds": "Feed",
     "menu.categories": "Categorie",
+    "menu.tags": "Etichette",
     "menu.settings": "Impostazioni",
     "menu.logout": "Esci",
     "menu.preferences": "Preferenze",
@@ -1173,14 +1736,20 @@ var translations = map[string]string{
     "menu.sessions": "Sessioni",
     "menu.users": "Utenti",
     "menu.about": "Informazioni",
+    "menu.rules": "Regole",
     "menu.export": "Esporta",
     "menu.import": "Importa",
     "menu.create_category": "Aggiungi una categoria",
+    "menu.create_rule": "Crea una regola",
+    "menu.site_rules": "Regole dei siti",
+    "menu.create_site_rule": "Crea una regola del sito",
     "menu.mark_page_as_read": "Segna questa pagina come letta",
     "menu.mark_all_as_read": "Segna tutti gli articoli come letti",
     "menu.mark_all_as_read_wip": "Operazione in corso...",
     "menu.show_all_entries": "Mostra tutte le voci",
     "menu.show_only_unread_entries": "Mostra solo voci non lette",
+    "menu.show_only_short_reads": "Mostra solo le letture brevi (meno di 5 minuti)",
+    "menu.show_all_reading_times": "Mostra articoli di qualsiasi tempo di lettura",
     "menu.refresh_feed": "Aggiorna",
     "menu.refresh_all_feeds": "Aggiorna tutti i feed in background",
     "menu.edit_feed": "Modifica",
@@ -1208,14 +1777,59 @@ var translations = map[string]string{
     "entry.original.label": "Contenuto originale",
     "entry.comments.label": "Commenti",
     "entry.comments.title": "Mostra i commenti",
+    "entry.estimated_reading_time": [
+        "%d minuto di lettura",
+        "%d minuti di lettura"
+    ],
+    "entry.revisions.label": "Modifiche",
+    "entry.podcast.season": "Stagione %d",
+    "entry.podcast.episode": "Episodio %d",
+    "entry.podcast.explicit": "Esplicito",
+    "entry.media.playback_speed": "Velocità di riproduzione",
+    "entry.revisions.title": "Visualizza le modifiche apportate a questo articolo",
+    "entry.updated": "aggiornato",
     "page.unread.title": "Da leggere",
     "page.starred.title": "Preferiti",
     "page.categories.title": "Categorie",
+    "page.tags.title": "Etichette",
+    "page.tags.entry_count": [
+        "C'è %d articolo.",
+        "Ci sono %d articoli."
+    ],
     "page.categories.no_feed": "Nessun feed.",
     "page.categories.feed_count": [
         "C'è %d feed.",
         "Ci sono %d feed."
     ],
+    "page.rules.title": "Regole",
+    "page.new_rule.title": "Nuova regola",
+    "page.edit_rule.title": "Modifica regola: %s",
+    "page.rules.feed": "Feed: %s",
+    "page.rules.category": "Categoria: %s",
+    "page.rules.title_pattern": "Titolo: %s",
+    "page.rules.content_pattern": "Contenuto: %s",
+    "page.rules.author_pattern": "Autore: %s",
+    "page.rules.older_than": [
+        "Più vecchio di %d giorno",
+        "Più vecchio di %d giorni"
+    ],
+    "page.rules.match_count": [
+        "%d articolo corrispondente",
+        "%d articoli corrispondenti"
+    ],
+    "page.rules.apply": "Applica agli articoli esistenti",
+    "page.site_rules.title": "Regole dei siti",
+    "page.new_site_rule.title": "Nuova regola del sito",
+    "page.edit_site_rule.title": "Modifica regola del sito: %s",
+    "page.site_rules.help": "Le regole dei siti vengono usate quando un feed non definisce le proprie regole di estrazione o di riscrittura. Le tue regole hanno la precedenza su quelle condivise con tutti gli utenti. Una regola disattivata disattiva le regole predefinite per il dominio.",
+    "page.site_rules.global": "Condivisa con tutti gli utenti",
+    "page.site_rules.private": "Privata",
+    "page.site_rules.disabled": "Disattivata",
+    "page.site_rules.scraper_rules": "Regole di estrazione: %s",
+    "page.site_rules.rewrite_rules": "Regole di riscrittura: %s",
+    "page.site_rules.builtin": "Regole integrate",
+    "page.site_rules.override": "Sostituisci",
+    "page.site_rules.global_help": "Questa regola è condivisa con tutti gli utenti.",
     "page.new_category.title": "Nuova categoria",
     "page.new_user.title": "Nuovo utente",
     "page.edit_category.title": "Modifica categoria: %s",
@@ -1226,6 +1840,8 @@ var translations = map[string]string{
         "%d errore",
         "%d errori"
     ],
+    "page.feeds.next_retry": "Prossimo tentativo:",
+    "page.feeds.disabled": "Disabilitato",
     "page.history.title": "Cronologia",
     "page.import.title": "Importa",
     "page.search.title": "Risultati della ricerca",
@@ -1243,11 +1859,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Scegli un feed",
     "page.edit_feed.title": "Modifica feed: %s",
     "page.edit_feed.last_check": "Ultimo controllo:",
+    "page.edit_feed.next_check": "Prossimo controllo:",
+    "page.edit_feed.websub_hub": "Hub WebSub:",
+    "page.edit_feed.websub_lease": "aggiornamenti inviati fino al %s",
     "page.edit_feed.last_modified_header": "Header LastModified:",
     "page.edit_feed.etag_header": "Header ETag:",
     "page.edit_feed.no_header": "Nessun header",
     "page.edit_feed.last_parsing_error": "Ultimo errore di parsing",
+    "page.edit_feed.fetch_history": "Cronologia dei download",
+    "page.edit_feed.fetch_history.date": "Data",
+    "page.edit_feed.fetch_history.status": "Stato HTTP",
+    "page.edit_feed.fetch_history.duration": "Durata",
+    "page.edit_feed.fetch_history.size": "Dimensione (byte)",
+    "page.edit_feed.fetch_history.entries": "Articoli",
+    "page.edit_feed.fetch_history.entries_count": "%d nuovi, %d aggiornati",
+    "page.edit_feed.fetch_history.error": "Errore",
+    "page.edit_feed.fetch_history.not_modified": "non modificato",
+    "page.edit_feed.fetch_history.moved": "Spostato da %s a %s",
     "page.entry.attachments": "Allegati",
+    "page.entry.duplicates": "Pubblicato anche in",
+    "page.entry.tags": "Etichette",
+    "page.entry_revisions.title": "Modifiche dell'articolo",
+    "page.entry_revisions.changed_at": "Modificato",
+    "page.entry_revisions.same_content": "Il contenuto non è cambiato.",
     "page.keyboard_shortcuts.title": "Scorciatoie da tastiera",
     "page.keyboard_shortcuts.subtitle.sections": "Navigazione sezioni",
     "page.keyboard_shortcuts.subtitle.items": "Navigazione articoli",
@@ -1305,11 +1939,20 @@ var translations = map[string]string{
     "page.sessions.table.actions": "Azioni",
     "page.sessions.table.current_session": "Sessione corrente",
     "alert.no_bookmark": "Nessun preferito disponibile.",
+    "alert.no_tag": "Nessuna etichetta disponibile.",
+    "alert.no_tag_entry": "Nessun articolo con questa etichetta.",
     "alert.no_category": "Nessuna categoria disponibile.",
     "alert.no_category_entry": "Questa categoria non contiene alcun articolo.",
     "alert.no_feed_entry": "Questo feed non contiene alcun articolo.",
     "alert.no_feed": "Nessun feed disponibile.",
     "alert.no_history": "La tua cronologia al momento è vuota.",
+    "alert.no_revision": "Non ci sono versioni precedenti di questo articolo.",
+    "alert.no_rule": "Non ci sono regole.",
+    "alert.no_site_rule": "Nessuna regola del sito.",
+    "alert.rule_applied": [
+        "La regola corrisponde a %d articolo.",
+        "La regola corrisponde a %d articoli."
+    ],
     "alert.feed_error": "Sembra ci sia un problema con questo feed",
     "alert.no_search_result": "La ricerca non ha prodotto risultati.",
     "alert.no_unread_entry": "Nessun articolo da leggere.",
@@ -1334,6 +1977,23 @@ var translations = map[string]string{
     "error.empty_file": "Questo file è vuoto.",
     "error.bad_credentials": "Nome utente o password non validi.",
     "error.fields_mandatory": "Tutti i campi sono obbligatori.",
+    "error.invalid_proxy_url": "URL del proxy non valido, sono supportati solo proxy http, https e socks5.",
+    "error.invalid_duplicate_policy": "Regola non valida per gli articoli duplicati.",
+    "error.invalid_filter_rules": "Regole di filtro non valide.",
+    "error.rule_condition_required": "La regola deve avere almeno una condizione.",
+    "error.rule_action_required": "La regola deve avere almeno un'azione.",
+    "error.rule_condition_not_found": "Il feed o la categoria selezionata non esiste.",
+    "error.invalid_rule_age": "L'età degli articoli deve essere un numero positivo di giorni.",
+    "error.invalid_rule_pattern": "Espressione regolare non valida: %s",
+    "error.unable_to_create_rule": "Impossibile creare questa regola.",
+    "error.unable_to_update_rule": "Impossibile aggiornare questa regola.",
+    "error.domain_required": "Il dominio è obbligatorio.",
+    "error.invalid_domain": "Dominio %q non valido, è previsto solo il nome host (ad esempio: example.org).",
+    "error.site_rule_already_exists": "Esiste già una regola del sito per questo dominio.",
+    "error.unable_to_create_site_rule": "Impossibile creare questa regola del sito.",
+    "error.unable_to_update_site_rule": "Impossibile aggiornare questa regola del sito.",
+    "error.invalid_headers": "Intestazioni personalizzate o cookie non validi.",
+    "error.encryption_key_missing": "Le intestazioni personalizzate e i cookie non possono essere salvati perché non è configurata alcuna chiave di cifratura (ENCRYPTION_KEY).",
     "error.title_required": "Il titolo è obbligatorio.",
     "error.different_passwords": "Le password non coincidono.",
     "error.password_min_length": "La password deve contenere almeno 6 caratteri.",
@@ -1341,15 +2001,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "L'URL e la categoria sono obbligatori.",
     "error.user_mandatory_fields": "Il nome utente è obbligatorio.",
     "form.feed.label.title": "Titolo",
+    "form.entry.label.tags": "Etichette (separate da virgole)",
     "form.feed.label.site_url": "URL del sito",
     "form.feed.label.feed_url": "URL del feed",
     "form.feed.label.category": "Categoria",
     "form.feed.label.crawler": "Scarica il contenuto integrale",
+    "form.feed.label.mark_unread_on_update": "Segna gli articoli aggiornati come non letti",
+    "form.feed.label.keep_tracking_parameters": "Mantieni i parametri di tracciamento dei link (utm_source, fbclid...)",
+    "form.feed.label.resolve_final_url": "Segui i reindirizzamenti dei link degli articoli per salvare il loro URL finale (FeedBurner, accorciatori di link...)",
+    "form.feed.label.disabled": "Non aggiornare questo feed",
     "form.feed.label.feed_username": "Nome utente del feed",
     "form.feed.label.feed_password": "Password del feed",
     "form.feed.label.user_agent": "Usa user agent personalizzato",
+    "form.feed.label.proxy_url": "URL del proxy (http, https o socks5)",
+    "form.feed.label.headers": "Intestazioni personalizzate (un \"Nome: Valore\" per riga)",
+    "form.feed.label.cookie": "Cookie",
     "form.feed.label.scraper_rules": "Regole di estrazione del contenuto",
     "form.feed.label.rewrite_rules": "Regole di impaginazione del contenuto",
+    "form.feed.label.keep_rules": "Conserva solo gli articoli che corrispondono a queste regole",
+    "form.feed.label.block_rules": "Blocca gli articoli che corrispondono a queste regole",
+    "form.feed.help.filter_rules": "Un'espressione regolare per riga, eventualmente preceduta dal campo: title, content, author, url o category.",
+    "form.feed.help.scraper_rules": "Selettori CSS del contenuto, seguiti facoltativamente da \"!\" e dai selettori CSS degli elementi da rimuovere.",
+    "form.feed.help.rewrite_rules": "Regole separate da virgole: add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"sostituzione\"), replace_title(\"regex\"|\"sostituzione\"), remove(\"selettore CSS\") e base64_decode(\"selettore CSS\").",
+    "form.feed.help.undecryptable_headers": "Le intestazioni o il cookie salvati non possono essere decifrati con la chiave di cifratura attuale, non vengono inviati e saranno sostituiti dai valori di questo modulo al salvataggio.",
+    "form.feed.label.filter_action": "Articoli bloccati",
+    "form.feed.select.filter_drop": "Ignorali",
+    "form.feed.select.filter_read": "Salvali come letti",
+    "form.rule.label.title": "Titolo",
+    "form.rule.section.conditions": "Condizioni",
+    "form.rule.section.actions": "Azioni",
+    "form.rule.label.feed": "Feed",
+    "form.rule.select.any_feed": "Qualsiasi feed",
+    "form.rule.label.category": "Categoria",
+    "form.rule.select.any_category": "Qualsiasi categoria",
+    "form.rule.label.title_pattern": "Il titolo corrisponde a",
+    "form.rule.label.content_pattern": "Il contenuto corrisponde a",
+    "form.rule.label.author_pattern": "L'autore corrisponde a",
+    "form.rule.label.older_than": "Pubblicato più di N giorni fa",
+    "form.rule.help.conditions": "Gli articoli devono soddisfare tutte le condizioni, quelle vuote vengono ignorate. I modelli sono espressioni regolari.",
+    "form.rule.label.mark_as_read": "Segna come letto",
+    "form.rule.label.star": "Aggiungi ai preferiti",
+    "form.rule.label.send_to_integrations": "Invia ai servizi di terze parti",
+    "form.rule.label.tag": "Aggiungi il tag",
+    "form.site_rule.label.domain": "Dominio",
+    "form.site_rule.label.disabled": "Disattiva le regole predefinite per questo dominio",
+    "form.site_rule.label.global": "Condividi questa regola con tutti gli utenti",
     "form.category.label.title": "Titolo",
     "form.user.label.username": "Nome utente",
     "form.user.label.password": "Password",
@@ -1362,6 +2058,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Prima i più recenti",
     "form.prefs.select.recent_first": "Prima i più vecchi",
     "form.prefs.label.keyboard_shortcuts": "Abilita le scorciatoie da tastiera",
+    "form.prefs.label.duplicate_policy": "Articoli già pubblicati da un altro feed",
+    "form.prefs.select.duplicate_none": "Mantenerli come non letti",
+    "form.prefs.select.duplicate_read": "Segnarli come letti",
+    "form.prefs.select.duplicate_group": "Raggrupparli sotto il primo articolo",
+    "form.prefs.label.duplicate_match_title": "Rilevare gli articoli duplicati anche dal titolo",
+    "form.prefs.label.mark_read_on_media_completion": "Segnare gli articoli con un podcast o un video come letti solo al termine della riproduzione",
     "form.import.label.file": "File OPML",
     "form.integration.fever_activate": "Abilita l'API di Fever",
     "form.integration.fever_username": "Nome utente dell'account Fever",
@@ -1441,6 +2143,7 @@ var translations = map[string]string{
     "menu.history": "Geschiedenis",
     "menu.feeds": "Feeds",
     "menu.categories": "Categorieën",
+    "menu.tags": "Labels",
     "menu.settings": "Instellingen",
     "menu.logout": "Uitloggen",
     "menu.preferences": "Voorkeuren",
@@ -1448,14 +2151,20 @@ var translations = map[string]string{
     "menu.sessions": "Sessies",
     "menu.users": "Users",
     "menu.about": "Over",
+    "menu.rules": "Regels",
     "menu.export": "Exporteren",
     "menu.import": "Importeren",
     "menu.create_category": "Categorie toevoegen",
+    "menu.create_rule": "Regel aanmaken",
+    "menu.site_rules": "Siteregels",
+    "menu.create_site_rule": "Siteregel toevoegen",
     "menu.mark_page_as_read": "Markeer deze pagina als gelezen",
     "menu.mark_all_as_read": "Markeer alle items als gelezen",
     "menu.mark_all_as_read_wip": "Bezig...",
     "menu.show_all_entries": "Toon alle artikelen",
     "menu.show_only_unread_entries": "Toon alleen ongelezen artikelen",
+    "menu.show_only_short_reads": "Alleen korte artikelen tonen (minder dan 5 minuten)",
+    "menu.show_all_reading_times": "Artikelen van elke leestijd tonen",
     "menu.refresh_feed": "Vernieuwen",
     "menu.refresh_all_feeds": "Vernieuw alle feeds in de achtergrond",
     "menu.edit_feed": "Bewerken",
@@ -1483,14 +2192,59 @@ var translations = map[string]string{
     "entry.original.label": "Origineel",
     "entry.comments.label": "Comments",
     "entry.comments.title": "Bekijk de reacties",
+    "entry.estimated_reading_time": [
+        "%d minuut leestijd",
+        "%d minuten leestijd"
+    ],
+    "entry.revisions.label": "Wijzigingen",
+    "entry.podcast.season": "Seizoen %d",
+    "entry.podcast.episode": "Aflevering %d",
+    "entry.podcast.explicit": "Expliciet",
+    "entry.media.playback_speed": "Afspeelsnelheid",
+    "entry.revisions.title": "De wijzigingen aan dit artikel bekijken",
+    "entry.updated": "bijgewerkt",
     "page.unread.title": "Ongelezen",
     "page.starred.title": "Favorieten",
     "page.categories.title": "Categorieën",
+    "page.tags.title": "Labels",
+    "page.tags.entry_count": [
+        "Er is %d artikel.",
+        "Er zijn %d artikelen."
+    ],
     "page.categories.no_feed": "Geen feeds.",
     "page.categories.feed_count": [
         "Er is %d feed.",
         "Er zijn %d feeds."
     ],
+    "page.rules.title": "Regels",
+    "page.new_rule.title": "Nieuwe regel",
+    "page.edit_rule.title": "Regel bewerken: %s",
+    "page.rules.feed": "Feed: %s",
+    "page.rules.category": "Categorie: %s",
+    "page.rules.title_pattern": "Titel: %s",
+    "page.rules.content_pattern": "Inhoud: %s",
+    "page.rules.author_pattern": "Auteur: %s",
+    "page.rules.older_than": [
+        "Ouder dan %d dag",
+        "Ouder dan %d dagen"
+    ],
+    "page.rules.match_count": [
+        "%d overeenkomend artikel",
+        "%d overeenkomende artikelen"
+    ],
+    "page.rules.apply": "Toepassen op bestaande artikelen",
+    "page.site_rules.title": "Siteregels",
+    "page.new_site_rule.title": "Nieuwe siteregel",
+    "page.edit_site_rule.title": "Siteregel bewerken: %s",
+    "page.site_rules.help": "Siteregels worden gebruikt wanneer een feed geen eigen scraper- of herschrijfregels heeft. Uw regels hebben voorrang op de regels die met alle gebruikers worden gedeeld. Een uitgeschakelde regel schakelt de vooraf gedefinieerde regels voor het domein uit.",
+    "page.site_rules.global": "Gedeeld met alle gebruikers",
+    "page.site_rules.private": "Privé",
+    "page.site_rules.disabled": "Uitgeschakeld",
+    "page.site_rules.scraper_rules": "Scraper-regels: %s",
+    "page.site_rules.rewrite_rules": "Herschrijfregels: %s",
+    "page.site_rules.builtin": "Ingebouwde regels",
+    "page.site_rules.override": "Overschrijven",
+    "page.site_rules.global_help": "Deze regel wordt gedeeld met alle gebruikers.",
     "page.new_category.title": "Nieuwe categorie",
     "page.new_user.title": "Nieuwe gebruiker",
     "page.edit_category.title": "Bewerken van categorie: %s",
@@ -1501,6 +2255,8 @@ var translations = map[string]string{
         "%d error",
         "%d errors"
     ],
+    "page.feeds.next_retry": "Volgende poging:",
+    "page.feeds.disabled": "Uitgeschakeld",
     "page.history.title": "Geschiedenis",
     "page.import.title": "Importeren",
     "page.login.title": "Inloggen",
@@ -1519,11 +2275,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Feed kiezen",
     "page.edit_feed.title": "Bewerken van feed: %s",
     "page.edit_feed.last_check": "Laatste update:",
+    "page.edit_feed.next_check": "Volgende update:",
+    "page.edit_feed.websub_hub": "WebSub-hub:",
+    "page.edit_feed.websub_lease": "updates worden gepusht tot %s",
     "page.edit_feed.last_modified_header": "LastModified-header:",
     "page.edit_feed.etag_header": "ETAG-header:",
     "page.edit_feed.no_header": "Geen",
     "page.edit_feed.last_parsing_error": "Laatste parse error",
+    "page.edit_feed.fetch_history": "Ophaalgeschiedenis",
+    "page.edit_feed.fetch_history.date": "Datum",
+    "page.edit_feed.fetch_history.status": "HTTP-status",
+    "page.edit_feed.fetch_history.duration": "Duur",
+    "page.edit_feed.fetch_history.size": "Grootte (bytes)",
+    "page.edit_feed.fetch_history.entries": "Artikelen",
+    "page.edit_feed.fetch_history.entries_count": "%d nieuw, %d bijgewerkt",
+    "page.edit_feed.fetch_history.error": "Fout",
+    "page.edit_feed.fetch_history.not_modified": "niet gewijzigd",
+    "page.edit_feed.fetch_history.moved": "Verplaatst van %s naar %s",
     "page.entry.attachments": "Bijlagen",
+    "page.entry.duplicates": "Ook gepubliceerd in",
+    "page.entry.tags": "Labels",
+    "page.entry_revisions.title": "Wijzigingen van het artikel",
+    "page.entry_revisions.changed_at": "Gewijzigd",
+    "page.entry_revisions.same_content": "De inhoud is niet gewijzigd.",
     "page.keyboard_shortcuts.title": "Sneltoetsen",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguatie tussen menu's",
     "page.keyboard_shortcuts.subtitle.items": "Navigatie tussen items",
@@ -1580,11 +2354,20 @@ var translations = map[string]string{
     "page.sessions.table.actions": "Acties",
     "page.sessions.table.current_session": "Huidige sessie",
     "alert.no_bookmark": "Er zijn op dit moment geen favorieten.",
+    "alert.no_tag": "Er zijn op dit moment geen labels.",
+    "alert.no_tag_entry": "Er zijn geen artikelen met dit label.",
     "alert.no_category": "Er zijn geen categorieën.",
     "alert.no_category_entry": "Deze categorie bevat geen feeds.",
     "alert.no_feed_entry": "Er zijn geen artikelen in deze feed.",
     "alert.no_feed": "Je hebt nog geen feeds geabboneerd staan.",
     "alert.no_history": "Geschiedenis is op dit moment leeg.",
+    "alert.no_revision": "Er zijn geen eerdere versies van dit artikel.",
+    "alert.no_rule": "Er zijn geen regels.",
+    "alert.no_site_rule": "Er zijn geen siteregels.",
+    "alert.rule_applied": [
+        "De regel kwam overeen met %d artikel.",
+        "De regel kwam overeen met %d artikelen."
+    ],
     "alert.feed_error": "Er is een probleem met deze feed",
     "alert.no_search_result": "Er is geen resultaat voor deze zoekopdracht.",
     "alert.no_unread_entry": "Er zijn geen ongelezen artikelen.",
@@ -1609,6 +2392,23 @@ var translations = map[string]string{
     "error.empty_file": "Dit bestand is leeg.",
     "error.bad_credentials": "Onjuiste gebruikersnaam of wachtwoord.",
     "error.fields_mandatory": "Alle velden moeten ingevuld zijn.",
+    "error.invalid_proxy_url": "Ongeldige proxy-URL, alleen http-, https- en socks5-proxy's worden ondersteund.",
+    "error.invalid_duplicate_policy": "Ongeldige regel voor dubbele artikelen.",
+    "error.invalid_filter_rules": "Ongeldige filterregels.",
+    "error.rule_condition_required": "De regel moet minstens één voorwaarde hebben.",
+    "error.rule_action_required": "De regel moet minstens één actie hebben.",
+    "error.rule_condition_not_found": "De geselecteerde feed of categorie bestaat niet.",
+    "error.invalid_rule_age": "De leeftijd van de artikelen moet een positief aantal dagen zijn.",
+    "error.invalid_rule_pattern": "Ongeldige reguliere expressie: %s",
+    "error.unable_to_create_rule": "Kan deze regel niet aanmaken.",
+    "error.unable_to_update_rule": "Kan deze regel niet bijwerken.",
+    "error.domain_required": "Het domein is verplicht.",
+    "error.invalid_domain": "Ongeldig domein %q, alleen de hostnaam wordt verwacht (bijvoorbeeld: example.org).",
+    "error.site_rule_already_exists": "Er bestaat al een siteregel voor dit domein.",
+    "error.unable_to_create_site_rule": "Kan deze siteregel niet maken.",
+    "error.unable_to_update_site_rule": "Kan deze siteregel niet bijwerken.",
+    "error.invalid_headers": "Ongeldige aangepaste headers of cookie.",
+    "error.encryption_key_missing": "Aangepaste headers en cookies kunnen niet worden opgeslagen omdat er geen encryptiesleutel is ingesteld (ENCRYPTION_KEY).",
     "error.title_required": "Naam van categorie is verplicht.",
     "error.different_passwords": "Wachtwoorden zijn niet hetzelfde.",
     "error.password_min_length": "Je moet minstens 6 tekens gebruiken.",
@@ -1616,15 +2416,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "The URL en de categorie zijn verplicht.",
     "error.user_mandatory_fields": "Gebruikersnaam is verplicht",
     "form.feed.label.title": "Naam",
+    "form.entry.label.tags": "Labels (gescheiden door komma's)",
     "form.feed.label.site_url": "Website URL",
     "form.feed.label.feed_url": "Feed URL",
     "form.feed.label.category": "Categorie",
     "form.feed.label.crawler": "Download originele content",
+    "form.feed.label.mark_unread_on_update": "Bijgewerkte artikelen als ongelezen markeren",
+    "form.feed.label.keep_tracking_parameters": "Trackingparameters van links behouden (utm_source, fbclid...)",
+    "form.feed.label.resolve_final_url": "Omleidingen van artikellinks volgen om hun uiteindelijke URL op te slaan (FeedBurner, linkverkorters...)",
+    "form.feed.label.disabled": "Deze feed niet vernieuwen",
     "form.feed.label.feed_username": "Feed-gebruikersnaam",
     "form.feed.label.feed_password": "Feed wachtwoord",
     "form.feed.label.user_agent": "Standaard User Agent overschrijven",
+    "form.feed.label.proxy_url": "Proxy-URL (http, https of socks5)",
+    "form.feed.label.headers": "Aangepaste headers (één \"Naam: Waarde\" per regel)",
+    "form.feed.label.cookie": "Cookie",
     "form.feed.label.scraper_rules": "Scraper regels",
     "form.feed.label.rewrite_rules": "Rewrite regels",
+    "form.feed.label.keep_rules": "Alleen artikelen behouden die aan deze regels voldoen",
+    "form.feed.label.block_rules": "Artikelen blokkeren die aan deze regels voldoen",
+    "form.feed.help.filter_rules": "Eén reguliere expressie per regel, optioneel voorafgegaan door het veld: title, content, author, url of category.",
+    "form.feed.help.scraper_rules": "CSS-selectors van de inhoud, eventueel gevolgd door \"!\" en de CSS-selectors van de te verwijderen elementen.",
+    "form.feed.help.rewrite_rules": "Regels gescheiden door komma's: add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"vervanging\"), replace_title(\"regex\"|\"vervanging\"), remove(\"CSS-selector\") en base64_decode(\"CSS-selector\").",
+    "form.feed.help.undecryptable_headers": "De opgeslagen headers of cookie kunnen niet worden ontsleuteld met de huidige encryptiesleutel, ze worden niet verzonden en worden bij het opslaan vervangen door de waarden van dit formulier.",
+    "form.feed.label.filter_action": "Geblokkeerde artikelen",
+    "form.feed.select.filter_drop": "Negeren",
+    "form.feed.select.filter_read": "Opslaan als gelezen",
+    "form.rule.label.title": "Titel",
+    "form.rule.section.conditions": "Voorwaarden",
+    "form.rule.section.actions": "Acties",
+    "form.rule.label.feed": "Feed",
+    "form.rule.select.any_feed": "Elke feed",
+    "form.rule.label.category": "Categorie",
+    "form.rule.select.any_category": "Elke categorie",
+    "form.rule.label.title_pattern": "Titel komt overeen met",
+    "form.rule.label.content_pattern": "Inhoud komt overeen met",
+    "form.rule.label.author_pattern": "Auteur komt overeen met",
+    "form.rule.label.older_than": "Meer dan N dagen geleden gepubliceerd",
+    "form.rule.help.conditions": "De artikelen moeten aan alle voorwaarden voldoen, lege voorwaarden worden genegeerd. De patronen zijn reguliere expressies.",
+    "form.rule.label.mark_as_read": "Markeren als gelezen",
+    "form.rule.label.star": "Markeren met ster",
+    "form.rule.label.send_to_integrations": "Naar diensten van derden sturen",
+    "form.rule.label.tag": "Label toevoegen",
+    "form.site_rule.label.domain": "Domein",
+    "form.site_rule.label.disabled": "Vooraf gedefinieerde regels voor dit domein uitschakelen",
+    "form.site_rule.label.global": "Deze regel delen met alle gebruikers",
     "form.category.label.title": "Naam",
     "form.user.label.username": "Gebruikersnaam",
     "form.user.label.password": "Wachtwoord",
@@ -1637,6 +2473,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Oudere items eerst",
     "form.prefs.select.recent_first": "Recente items eerst",
     "form.prefs.label.keyboard_shortcuts": "Schakel sneltoetsen in",
+    "form.prefs.label.duplicate_policy": "Artikelen die al door een andere feed zijn gepubliceerd",
+    "form.prefs.select.duplicate_none": "Ongelezen laten",
+    "form.prefs.select.duplicate_read": "Als gelezen markeren",
+    "form.prefs.select.duplicate_group": "Groeperen onder het eerste artikel",
+    "form.prefs.label.duplicate_match_title": "Dubbele artikelen ook op titel herkennen",
+    "form.prefs.label.mark_read_on_media_completion": "Artikelen met een podcast of video pas als gelezen markeren wanneer het afspelen klaar is",
     "form.import.label.file": "OPML-bestand",
     "form.integration.fever_activate": "Activeer Fever API",
     "form.integration.fever_username": "Fever gebruikersnaam",
@@ -1734,6 +2576,7 @@ var translations = map[string]string{
     "menu.history": "Historia",
     "menu.feeds": "Kanały",
     "menu.categories": "Kategorie",
+    "menu.tags": "Etykiety",
     "menu.settings": "Ustawienia",
     "menu.logout": "Wyloguj się",
     "menu.preferences": "Preferencje",
@@ -1741,14 +2584,20 @@ var translations = map[string]string{
     "menu.sessions": "Sesje",
     "menu.users": "Użytkownicy",
     "menu.about": "O stronie",
+    "menu.rules": "Reguły",
     "menu.export": "Eksportuj",
     "menu.import": "Importuj",
     "menu.create_category": "Utwórz kategorię",
+    "menu.create_rule": "Utwórz regułę",
+    "menu.site_rules": "Reguły witryn",
+    "menu.create_site_rule": "Utwórz regułę witryny",
     "menu.mark_page_as_read": "Oznacz jako przeczytane",
     "menu.mark_all_as_read": "Oznacz wszystko jako przeczytane",
     "menu.mark_all_as_read_wip": "W toku...",
     "menu.show_all_entries": "Pokaż wszystkie artykuły",
     "menu.show_only_unread_entries": "Pokaż tylko nieprzeczytane artykuły",
+    "menu.show_only_short_reads": "Pokaż tylko krótkie artykuły (poniżej 5 minut)",
+    "menu.show_all_reading_times": "Pokaż artykuły o dowolnym czasie czytania",
     "menu.refresh_feed": "Odśwież",
     "menu.refresh_all_feeds": "Odśwież wszystkie subskrypcje w tle",
     "menu.edit_feed": "Edytuj",
@@ -1776,15 +2625,64 @@ var translations = map[string]string{
     "entry.original.label": "Oryginalny artykuł",
     "entry.comments.label": "Komentarze",
     "entry.comments.title": "Zobacz komentarze",
+    "entry.estimated_reading_time": [
+        "%d minuta czytania",
+        "%d minuty czytania",
+        "%d minut czytania"
+    ],
+    "entry.revisions.label": "Zmiany",
+    "entry.podcast.season": "Sezon %d",
+    "entry.podcast.episode": "Odcinek %d",
+    "entry.podcast.explicit": "Treści dla dorosłych",
+    "entry.media.playback_speed": "Prędkość odtwarzania",
+    "entry.revisions.title": "Zobacz zmiany wprowadzone w tym artykule",
+    "entry.updated": "zaktualizowano",
     "page.unread.title": "Nieprzeczytane",
     "page.starred.title": "Oznaczone gwiazdką",
     "page.categories.title": "Kategorie",
+    "page.tags.title": "Etykiety",
+    "page.tags.entry_count": [
+        "Jest %d artykuł.",
+        "Są %d artykuły.",
+        "Jest %d artykułów."
+    ],
     "page.categories.no_feed": "Brak kanałów.",
     "page.categories.feed_count": [
         "Jest %d kanał.",
         "Są %d kanały.",
         "Jest %d kanałów."
     ],
+    "page.rules.title": "Reguły",
+    "page.new_rule.title": "Nowa reguła",
+    "page.edit_rule.title": "Edytuj regułę: %s",
+    "page.rules.feed": "Kanał: %s",
+    "page.rules.category": "Kategoria: %s",
+    "page.rules.title_pattern": "Tytuł: %s",
+    "page.rules.content_pattern": "Treść: %s",
+    "page.rules.author_pattern": "Autor: %s",
+    "page.rules.older_than": [
+        "Starsze niż %d dzień",
+        "Starsze niż %d dni",
+        "Starsze niż %d dni"
+    ],
+    "page.rules.match_count": [
+        "%d pasujący artykuł",
+        "%d pasujące artykuły",
+        "%d pasujących artykułów"
+    ],
+    "page.rules.apply": "Zastosuj do istniejących artykułów",
+    "page.site_rules.title": "Reguły witryn",
+    "page.new_site_rule.title": "Nowa reguła witryny",
+    "page.edit_site_rule.title": "Edytuj regułę witryny: %s",
+    "page.site_rules.help": "Reguły witryn są używane, gdy kanał nie definiuje własnych reguł pobierania ani przepisywania. Twoje reguły mają pierwszeństwo przed regułami udostępnionymi wszystkim użytkownikom. Wyłączona reguła wyłącza predefiniowane reguły dla domeny.",
+    "page.site_rules.global": "Udostępniona wszystkim użytkownikom",
+    "page.site_rules.private": "Prywatna",
+    "page.site_rules.disabled": "Wyłączona",
+    "page.site_rules.scraper_rules": "Reguły pobierania: %s",
+    "page.site_rules.rewrite_rules": "Reguły przepisywania: %s",
+    "page.site_rules.builtin": "Wbudowane reguły",
+    "page.site_rules.override": "Zastąp",
+    "page.site_rules.global_help": "Ta reguła jest udostępniona wszystkim użytkownikom.",
     "page.new_category.title": "Nowa kategoria",
     "page.new_user.title": "Nowy użytkownik",
     "page.edit_category.title": "Edycja Kategorii: %s",
@@ -1796,6 +2694,8 @@ var translations = map[string]string{
         "%d błąd",
         "%d błędów"
     ],
+    "page.feeds.next_retry": "Następna próba:",
+    "page.feeds.disabled": "Wyłączony",
     "page.history.title": "Historia",
     "page.import.title": "Importuj",
     "page.search.title": "Wyniki wyszukiwania",
@@ -1813,11 +2713,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Wybierz subskrypcję",
     "page.edit_feed.title": "Edytuj kanał: %s",
     "page.edit_feed.last_check": "Ostatnia aktualizacja:",
+    "page.edit_feed.next_check": "Następna aktualizacja:",
+    "page.edit_feed.websub_hub": "Hub WebSub:",
+    "page.edit_feed.websub_lease": "aktualizacje przesyłane do %s",
     "page.edit_feed.last_modified_header": "Ostatnio zmienione:",
     "page.edit_feed.etag_header": "Nagłówek ETag:",
     "page.edit_feed.no_header": "Brak",
     "page.edit_feed.last_parsing_error": "Ostatni błąd analizy",
+    "page.edit_feed.fetch_history": "Historia pobierania",
+    "page.edit_feed.fetch_history.date": "Data",
+    "page.edit_feed.fetch_history.status": "Status HTTP",
+    "page.edit_feed.fetch_history.duration": "Czas trwania",
+    "page.edit_feed.fetch_history.size": "Rozmiar (bajty)",
+    "page.edit_feed.fetch_history.entries": "Artykuły",
+    "page.edit_feed.fetch_history.entries_count": "%d nowych, %d zaktualizowanych",
+    "page.edit_feed.fetch_history.error": "Błąd",
+    "page.edit_feed.fetch_history.not_modified": "bez zmian",
+    "page.edit_feed.fetch_history.moved": "Przeniesiono z %s do %s",
     "page.entry.attachments": "Załączniki",
+    "page.entry.duplicates": "Opublikowano również w",
+    "page.entry.tags": "Etykiety",
+    "page.entry_revisions.title": "Zmiany artykułu",
+    "page.entry_revisions.changed_at": "Zmieniono",
+    "page.entry_revisions.same_content": "Treść nie uległa zmianie.",
     "page.keyboard_shortcuts.title": "Skróty klawiszowe",
     "page.keyboard_shortcuts.subtitle.sections": "Nawigacja między punktami menu",
     "page.keyboard_shortcuts.subtitle.items": "Nawigacja między artykułami",
@@ -1875,11 +2793,21 @@ var translations = map[string]string{
     "page.sessions.table.actions": "Działania",
     "page.sessions.table.current_session": "Bieżąca sesja",
     "alert.no_bookmark": "Obecnie nie ma żadnych zakładek.",
+    "alert.no_tag": "Obecnie nie ma żadnych etykiet.",
+    "alert.no_tag_entry": "Brak artykułów z tą etykietą.",
     "alert.no_category": "Nie ma żadnej kategorii!",
     "alert.no_category_entry": "W tej kategorii nie ma żadnych artykułów",
     "alert.no_feed_entry": "Nie ma artykułu dla tego kanału.",
     "alert.no_feed": "Nie masz żadnej subskrypcji.",
     "alert.no_history": "Obecnie nie ma żadnej historii.",
+    "alert.no_revision": "Brak wcześniejszych wersji tego artykułu.",
+    "alert.no_rule": "Nie ma żadnej reguły.",
+    "alert.no_site_rule": "Brak reguł witryn.",
+    "alert.rule_applied": [
+        "Reguła pasuje do %d artykułu.",
+        "Reguła pasuje do %d artykułów.",
+        "Reguła pasuje do %d artykułów."
+    ],
     "alert.feed_error": "Z tym kanałem jest problem",
     "alert.no_search_result": "Brak wyników dla tego wyszukiwania.",
     "alert.no_unread_entry": "Nie ma żadnych nieprzeczytanych artykułów.",
@@ -1904,6 +2832,23 @@ var translations = map[string]string{
     "error.empty_file": "Ten plik jest pusty.",
     "error.bad_credentials": "Nieprawidłowa nazwa użytkownika lub hasło.",
     "error.fields_mandatory": "Wszystkie pola są obowiązkowe.",
+    "error.invalid_proxy_url": "Nieprawidłowy adres URL serwera proxy, obsługiwane są tylko serwery http, https i socks5.",
+    "error.invalid_duplicate_policy": "Nieprawidłowa reguła dla zduplikowanych artykułów.",
+    "error.invalid_filter_rules": "Nieprawidłowe reguły filtrowania.",
+    "error.rule_condition_required": "Reguła musi mieć co najmniej jeden warunek.",
+    "error.rule_action_required": "Reguła musi mieć co najmniej jedną akcję.",
+    "error.rule_condition_not_found": "Wybrany kanał lub kategoria nie istnieje.",
+    "error.invalid_rule_age": "Wiek artykułów musi być dodatnią liczbą dni.",
+    "error.invalid_rule_pattern": "Nieprawidłowe wyrażenie regularne: %s",
+    "error.unable_to_create_rule": "Nie można utworzyć tej reguły.",
+    "error.unable_to_update_rule": "Nie można zaktualizować tej reguły.",
+    "error.domain_required": "Domena jest obowiązkowa.",
+    "error.invalid_domain": "Nieprawidłowa domena %q, oczekiwana jest tylko nazwa hosta (na przykład: example.org).",
+    "error.site_rule_already_exists": "Reguła witryny dla tej domeny już istnieje.",
+    "error.unable_to_create_site_rule": "Nie można utworzyć tej reguły witryny.",
+    "error.unable_to_update_site_rule": "Nie można zaktualizować tej reguły witryny.",
+    "error.invalid_headers": "Nieprawidłowe niestandardowe nagłówki lub ciasteczko.",
+    "error.encryption_key_missing": "Nie można zapisać niestandardowych nagłówków i ciasteczek, ponieważ nie skonfigurowano klucza szyfrowania (ENCRYPTION_KEY).",
     "error.title_required": "Tytuł jest obowiązkowy.",
     "error.different_passwords": "Hasła nie są identyczne.",
     "error.password_min_length": "Musisz użyć co najmniej 6 znaków.",
@@ -1911,15 +2856,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "URL i kategoria są obowiązkowe.",
     "error.user_mandatory_fields": "Nazwa użytkownika jest obowiązkowa.",
     "form.feed.label.title": "Tytuł",
+    "form.entry.label.tags": "Etykiety (oddzielone przecinkami)",
     "form.feed.label.site_url": "URL strony",
     "form.feed.label.feed_url": "URL kanału",
     "form.feed.label.category": "Kategoria",
     "form.feed.label.crawler": "Pobierz oryginalną treść",
+    "form.feed.label.mark_unread_on_update": "Oznacz zaktualizowane artykuły jako nieprzeczytane",
+    "form.feed.label.keep_tracking_parameters": "Zachowaj parametry śledzące w linkach (utm_source, fbclid...)",
+    "form.feed.label.resolve_final_url": "Podążaj za przekierowaniami linków artykułów, aby zapisać ich końcowy URL (FeedBurner, skracacze linków...)",
+    "form.feed.label.disabled": "Nie odświeżaj tego kanału",
     "form.feed.label.feed_username": "Subskrypcję nazwa użytkownika",
     "form.feed.label.feed_password": "Subskrypcję Hasło",
     "form.feed.label.user_agent": "Zastąp domyślny agent użytkownika",
+    "form.feed.label.proxy_url": "Adres URL serwera proxy (http, https lub socks5)",
+    "form.feed.label.headers": "Niestandardowe nagłówki (jeden \"Nazwa: Wartość\" w wierszu)",
+    "form.feed.label.cookie": "Ciasteczko",
     "form.feed.label.scraper_rules": "Zasady ekstrakcji",
     "form.feed.label.rewrite_rules": "Reguły zapisu",
+    "form.feed.label.keep_rules": "Zachowaj tylko artykuły pasujące do tych reguł",
+    "form.feed.label.block_rules": "Blokuj artykuły pasujące do tych reguł",
+    "form.feed.help.filter_rules": "Jedno wyrażenie regularne na linię, opcjonalnie poprzedzone polem: title, content, author, url lub category.",
+    "form.feed.help.scraper_rules": "Selektory CSS treści, opcjonalnie po nich \"!\" i selektory CSS elementów do usunięcia.",
+    "form.feed.help.rewrite_rules": "Reguły oddzielone przecinkami: add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"zamiennik\"), replace_title(\"regex\"|\"zamiennik\"), remove(\"selektor CSS\") i base64_decode(\"selektor CSS\").",
+    "form.feed.help.undecryptable_headers": "Zapisanych nagłówków lub ciasteczka nie można odszyfrować bieżącym kluczem szyfrowania, nie są wysyłane i zostaną zastąpione wartościami tego formularza po jego zapisaniu.",
+    "form.feed.label.filter_action": "Zablokowane artykuły",
+    "form.feed.select.filter_drop": "Ignoruj je",
+    "form.feed.select.filter_read": "Zapisz jako przeczytane",
+    "form.rule.label.title": "Tytuł",
+    "form.rule.section.conditions": "Warunki",
+    "form.rule.section.actions": "Akcje",
+    "form.rule.label.feed": "Kanał",
+    "form.rule.select.any_feed": "Dowolny kanał",
+    "form.rule.label.category": "Kategoria",
+    "form.rule.select.any_category": "Dowolna kategoria",
+    "form.rule.label.title_pattern": "Tytuł pasuje do",
+    "form.rule.label.content_pattern": "Treść pasuje do",
+    "form.rule.label.author_pattern": "Autor pasuje do",
+    "form.rule.label.older_than": "Opublikowane ponad N dni temu",
+    "form.rule.help.conditions": "Artykuły muszą spełniać wszystkie warunki, puste są ignorowane. Wzorce są wyrażeniami regularnymi.",
+    "form.rule.label.mark_as_read": "Oznacz jako przeczytane",
+    "form.rule.label.star": "Oznacz gwiazdką",
+    "form.rule.label.send_to_integrations": "Wyślij do usług zewnętrznych",
+    "form.rule.label.tag": "Dodaj tag",
+    "form.site_rule.label.domain": "Domena",
+    "form.site_rule.label.disabled": "Wyłącz predefiniowane reguły dla tej domeny",
+    "form.site_rule.label.global": "Udostępnij tę regułę wszystkim użytkownikom",
     "form.category.label.title": "Tytuł",
     "form.user.label.username": "Nazwa użytkownika",
     "form.user.label.password": "Hasło",
@@ -1931,6 +2912,12 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Sortowanie artykułów",
     "form.prefs.select.older_first": "Najstarsze wpisy jako pierwsze",
     "form.prefs.label.keyboard_shortcuts": "Włącz skróty klawiaturowe",
+    "form.prefs.label.duplicate_policy": "Artykuły już opublikowane przez inny kanał",
+    "form.prefs.select.duplicate_none": "Pozostaw jako nieprzeczytane",
+    "form.prefs.select.duplicate_read": "Oznacz jako przeczytane",
+    "form.prefs.select.duplicate_group": "Grupuj pod pierwszym artykułem",
+    "form.prefs.label.duplicate_match_title": "Wykrywaj zduplikowane artykuły także po tytule",
+    "form.prefs.label.mark_read_on_media_completion": "Oznaczaj artykuły z podcastem lub filmem jako przeczytane dopiero po zakończeniu odtwarzania",
     "form.prefs.select.recent_first": "Najnowsze wpisy jako pierwsze",
     "form.import.label.file": "Plik OPML",
     "form.integration.fever_activate": "Aktywuj Fever API",
@@ -2035,6 +3022,7 @@ var translations = map[string]string{
     "menu.history": "История",
     "menu.feeds": "Подписки",
     "menu.categories": "Категории",
+    "menu.tags": "Метки",
     "menu.settings": "Настройки",
     "menu.logout": "Выйти",
     "menu.preferences": "Предпочтения",
@@ -2042,14 +3030,20 @@ var translations = map[string]string{
     "menu.sessions": "Сессии",
     "menu.users": "Пользователи",
     "menu.about": "О приложении",
+    "menu.rules": "Правила",
     "menu.export": "Экспорт",
     "menu.import": "Импорт",
     "menu.create_category": "Создать категорию",
+    "menu.create_rule": "Создать правило",
+    "menu.site_rules": "Правила сайтов",
+    "menu.create_site_rule": "Создать правило сайта",
     "menu.mark_page_as_read": "Отметить эту страницу прочитанной",
     "menu.mark_all_as_read": "Отметить всё как прочитанное",
     "menu.mark_all_as_read_wip": "В процессе…",
     "menu.show_all_entries": "Показать все статьи",
     "menu.show_only_unread_entries": "Показывать только непрочитанные статьи",
+    "menu.show_only_short_reads": "Показать только короткие статьи (менее 5 минут)",
+    "menu.show_all_reading_times": "Показать статьи с любым временем чтения",
     "menu.refresh_feed": "Обновить",
     "menu.refresh_all_feeds": "Обновить все подписки в фоне",
     "menu.edit_feed": "Изменить",
@@ -2077,15 +3071,64 @@ var translations = map[string]string{
     "entry.original.label": "Оригинал",
     "entry.comments.label": "Комментарии",
     "entry.comments.title": "Показать комментарии",
+    "entry.estimated_reading_time": [
+        "%d минута чтения",
+        "%d минуты чтения",
+        "%d минут чтения"
+    ],
+    "entry.revisions.label": "Изменения",
+    "entry.podcast.season": "Сезон %d",
+    "entry.podcast.episode": "Эпизод %d",
+    "entry.podcast.explicit": "Для взрослых",
+    "entry.media.playback_speed": "Скорость воспроизведения",
+    "entry.revisions.title": "Посмотреть изменения этой статьи",
+    "entry.updated": "обновлено",
     "page.unread.title": "Непрочитанное",
     "page.starred.title": "Избранное",
     "page.categories.title": "Категории",
+    "page.tags.title": "Метки",
+    "page.tags.entry_count": [
+        "Есть %d статья.",
+        "Есть %d статьи.",
+        "Есть %d статей."
+    ],
     "page.categories.no_feed": "Нет подписок.",
     "page.categories.feed_count": [
         "Есть %d подписка.",
         "Есть %d подписки.",
         "Есть %d подписок."
     ],
+    "page.rules.title": "Правила",
+    "page.new_rule.title": "Новое правило",
+    "page.edit_rule.title": "Редактирование правила: %s",
+    "page.rules.feed": "Подписка: %s",
+    "page.rules.category": "Категория: %s",
+    "page.rules.title_pattern": "Заголовок: %s",
+    "page.rules.content_pattern": "Содержание: %s",
+    "page.rules.author_pattern": "Автор: %s",
+    "page.rules.older_than": [
+        "Старше %d дня",
+        "Старше %d дней",
+        "Старше %d дней"
+    ],
+    "page.rules.match_count": [
+        "%d подходящая статья",
+        "%d подходящие статьи",
+        "%d подходящих статей"
+    ],
+    "page.rules.apply": "Применить к существующим статьям",
+    "page.site_rules.title": "Правила сайтов",
+    "page.new_site_rule.title": "Новое правило сайта",
+    "page.edit_site_rule.title": "Изменить правило сайта: %s",
+    "page.site_rules.help": "Правила сайтов используются, если у подписки нет собственных правил извлечения или перезаписи. Ваши правила имеют приоритет над общими для всех пользователей. Отключённое правило отключает встроенные правила для домена.",
+    "page.site_rules.global": "Общее для всех пользователей",
+    "page.site_rules.private": "Личное",
+    "page.site_rules.disabled": "Отключено",
+    "page.site_rules.scraper_rules": "Правила извлечения: %s",
+    "page.site_rules.rewrite_rules": "Правила перезаписи: %s",
+    "page.site_rules.builtin": "Встроенные правила",
+    "page.site_rules.override": "Переопределить",
+    "page.site_rules.global_help": "Это правило общее для всех пользователей.",
     "page.new_category.title": "Новая категория",
     "page.new_user.title": "Новый пользователь",
     "page.edit_category.title": "Изменить категорию: %s",
@@ -2097,6 +3140,8 @@ var translations = map[string]string{
         "%d ошибки",
         "%d ошибок"
     ],
+    "page.feeds.next_retry": "Следующая попытка:",
+    "page.feeds.disabled": "Отключено",
     "page.history.title": "История",
     "page.import.title": "Импорт",
     "page.search.title": "Результаты поиска",
@@ -2114,11 +3159,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Выбрать подписку",
     "page.edit_feed.title": "Изменить подписку: %s",
     "page.edit_feed.last_check": "Последняя проверка:",
+    "page.edit_feed.next_check": "Следующая проверка:",
+    "page.edit_feed.websub_hub": "Хаб WebSub:",
+    "page.edit_feed.websub_lease": "обновления отправляются до %s",
     "page.edit_feed.last_modified_header": "Заголовок LastModified:",
     "page.edit_feed.etag_header": "Заголовок ETag:",
     "page.edit_feed.no_header": "Отсутствует",
     "page.edit_feed.last_parsing_error": "Последняя ошибка парсинга",
+    "page.edit_feed.fetch_history": "История загрузок",
+    "page.edit_feed.fetch_history.date": "Дата",
+    "page.edit_feed.fetch_history.status": "Статус HTTP",
+    "page.edit_feed.fetch_history.duration": "Длительность",
+    "page.edit_feed.fetch_history.size": "Размер (байты)",
+    "page.edit_feed.fetch_history.entries": "Статьи",
+    "page.edit_feed.fetch_history.entries_count": "%d новых, %d обновлено",
+    "page.edit_feed.fetch_history.error": "Ошибка",
+    "page.edit_feed.fetch_history.not_modified": "не изменено",
+    "page.edit_feed.fetch_history.moved": "Перемещено с %s на %s",
     "page.entry.attachments": "Вложения",
+    "page.entry.duplicates": "Также опубликовано в",
+    "page.entry.tags": "Метки",
+    "page.entry_revisions.title": "Изменения статьи",
+    "page.entry_revisions.changed_at": "Изменено",
+    "page.entry_revisions.same_content": "Содержимое не изменилось.",
     "page.keyboard_shortcuts.title": "Сочетания клавиш",
     "page.keyboard_shortcuts.subtitle.sections": "Навигация по секциям",
     "page.keyboard_shortcuts.subtitle.items": "Навигация по элементам",
@@ -2176,11 +3239,21 @@ var translations = map[string]string{
     "page.sessions.table.actions": "Действия",
     "page.sessions.table.current_session": "Текущая сессия",
     "alert.no_bookmark": "Нет закладок на данный момент.",
+    "alert.no_tag": "Нет меток.",
+    "alert.no_tag_entry": "Нет статей с этой меткой.",
     "alert.no_category": "Категории отсутствуют.",
     "alert.no_category_entry": "В этой категории нет статей.",
     "alert.no_feed_entry": "В этой подписке отсутствуют статьи.",
     "alert.no_feed": "У вас нет ни одной подписки.",
     "alert.no_history": "Истории пока нет.",
+    "alert.no_revision": "Предыдущих версий этой статьи нет.",
+    "alert.no_rule": "Нет правил.",
+    "alert.no_site_rule": "Нет правил сайтов.",
+    "alert.rule_applied": [
+        "Правило применено к %d статье.",
+        "Правило применено к %d статьям.",
+        "Правило применено к %d статьям."
+    ],
     "alert.feed_error": "С этой подпиской есть проблема",
     "alert.no_search_result": "Нет результатов для данного поискового запроса.",
     "alert.no_unread_entry": "Нет непрочитанных статей.",
@@ -2205,6 +3278,23 @@ var translations = map[string]string{
     "error.empty_file": "Этот файл пуст.",
     "error.bad_credentials": "Неверное имя пользователя или пароль.",
     "error.fields_mandatory": "Все поля обязательны.",
+    "error.invalid_proxy_url": "Неверный URL прокси, поддерживаются только прокси http, https и socks5.",
+    "error.invalid_duplicate_policy": "Неверное правило для дублирующихся статей.",
+    "error.invalid_filter_rules": "Неверные правила фильтрации.",
+    "error.rule_condition_required": "Правило должно содержать хотя бы одно условие.",
+    "error.rule_action_required": "Правило должно содержать хотя бы одно действие.",
+    "error.rule_condition_not_found": "Выбранная подписка или категория не существует.",
+    "error.invalid_rule_age": "Возраст статей должен быть положительным числом дней.",
+    "error.invalid_rule_pattern": "Неверное регулярное выражение: %s",
+    "error.unable_to_create_rule": "Не удалось создать правило.",
+    "error.unable_to_update_rule": "Не удалось обновить правило.",
+    "error.domain_required": "Домен обязателен.",
+    "error.invalid_domain": "Неверный домен %q, ожидается только имя хоста (например: example.org).",
+    "error.site_rule_already_exists": "Правило для этого домена уже существует.",
+    "error.unable_to_create_site_rule": "Не удалось создать правило сайта.",
+    "error.unable_to_update_site_rule": "Не удалось обновить правило сайта.",
+    "error.invalid_headers": "Неверные пользовательские заголовки или cookie.",
+    "error.encryption_key_missing": "Невозможно сохранить пользовательские заголовки и cookie, так как не задан ключ шифрования (ENCRYPTION_KEY).",
     "error.title_required": "Название обязательно.",
     "error.different_passwords": "Пароли не совпадают.",
     "error.password_min_length": "Вы должны использовать минимум 6 символов.",
@@ -2212,15 +3302,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "URL и категория обязательны.",
     "error.user_mandatory_fields": "Имя пользователя обязательно.",
     "form.feed.label.title": "Название",
+    "form.entry.label.tags": "Метки (через запятую)",
     "form.feed.label.site_url": "URL сайта",
     "form.feed.label.feed_url": "URL подписки",
     "form.feed.label.category": "Категория",
     "form.feed.label.crawler": "Извлечь оригинальное содержимое",
+    "form.feed.label.mark_unread_on_update": "Отмечать обновлённые статьи как непрочитанные",
+    "form.feed.label.keep_tracking_parameters": "Сохранять параметры отслеживания в ссылках (utm_source, fbclid...)",
+    "form.feed.label.resolve_final_url": "Следовать перенаправлениям ссылок статей и сохранять их конечный URL (FeedBurner, сокращатели ссылок...)",
+    "form.feed.label.disabled": "Не обновлять эту подписку",
     "form.feed.label.feed_username": "Имя пользователя подписки",
     "form.feed.label.feed_password": "Пароль подписки",
     "form.feed.label.user_agent": "Переопределить User Agent по умолчанию",
+    "form.feed.label.proxy_url": "URL прокси (http, https или socks5)",
+    "form.feed.label.headers": "Пользовательские заголовки (по одному \"Имя: Значение\" в строке)",
+    "form.feed.label.cookie": "Cookie",
     "form.feed.label.scraper_rules": "Правила Scraper",
     "form.feed.label.rewrite_rules": "Правила Rewrite",
+    "form.feed.label.keep_rules": "Оставлять только статьи, соответствующие этим правилам",
+    "form.feed.label.block_rules": "Блокировать статьи, соответствующие этим правилам",
+    "form.feed.help.filter_rules": "Одно регулярное выражение на строку, с необязательным префиксом поля: title, content, author, url или category.",
+    "form.feed.help.scraper_rules": "CSS-селекторы содержимого, за которыми может следовать «!» и CSS-селекторы удаляемых элементов.",
+    "form.feed.help.rewrite_rules": "Правила через запятую: add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"замена\"), replace_title(\"regex\"|\"замена\"), remove(\"CSS-селектор\") и base64_decode(\"CSS-селектор\").",
+    "form.feed.help.undecryptable_headers": "Сохранённые заголовки или cookie не удаётся расшифровать текущим ключом шифрования, они не отправляются и будут заменены значениями этой формы при её сохранении.",
+    "form.feed.label.filter_action": "Заблокированные статьи",
+    "form.feed.select.filter_drop": "Игнорировать",
+    "form.feed.select.filter_read": "Сохранять как прочитанные",
+    "form.rule.label.title": "Название",
+    "form.rule.section.conditions": "Условия",
+    "form.rule.section.actions": "Действия",
+    "form.rule.label.feed": "Подписка",
+    "form.rule.select.any_feed": "Любая подписка",
+    "form.rule.label.category": "Категория",
+    "form.rule.select.any_category": "Любая категория",
+    "form.rule.label.title_pattern": "Заголовок соответствует",
+    "form.rule.label.content_pattern": "Содержание соответствует",
+    "form.rule.label.author_pattern": "Автор соответствует",
+    "form.rule.label.older_than": "Опубликовано более N дней назад",
+    "form.rule.help.conditions": "Статьи должны удовлетворять всем условиям, пустые игнорируются. Шаблоны — это регулярные выражения.",
+    "form.rule.label.mark_as_read": "Пометить как прочитанное",
+    "form.rule.label.star": "Добавить в избранное",
+    "form.rule.label.send_to_integrations": "Отправить в сторонние сервисы",
+    "form.rule.label.tag": "Добавить метку",
+    "form.site_rule.label.domain": "Домен",
+    "form.site_rule.label.disabled": "Отключить встроенные правила для этого домена",
+    "form.site_rule.label.global": "Сделать правило общим для всех пользователей",
     "form.category.label.title": "Название",
     "form.user.label.username": "Имя пользователя",
     "form.user.label.password": "Пароль",
@@ -2233,6 +3359,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Сначала старые записи",
     "form.prefs.select.recent_first": "Сначала последние записи",
     "form.prefs.label.keyboard_shortcuts": "Включить сочетания клавиш",
+    "form.prefs.label.duplicate_policy": "Статьи, уже опубликованные другой подпиской",
+    "form.prefs.select.duplicate_none": "Оставлять непрочитанными",
+    "form.prefs.select.duplicate_read": "Отмечать как прочитанные",
+    "form.prefs.select.duplicate_group": "Группировать под первой статьёй",
+    "form.prefs.label.duplicate_match_title": "Также определять дубликаты по заголовку",
+    "form.prefs.label.mark_read_on_media_completion": "Отмечать статьи с подкастом или видео как прочитанные только после окончания воспроизведения",
     "form.import.label.file": "OPML файл",
     "form.integration.fever_activate": "Активировать Fever API",
     "form.integration.fever_username": "Имя пользователя Fever",
@@ -2318,6 +3450,7 @@ var translations = map[string]string{
     "menu.history": "历史",
     "menu.feeds": "源",
     "menu.categories": "分类",
+    "menu.tags": "标签",
     "menu.settings": "设置",
     "menu.logout": "登出",
     "menu.preferences": "设置",
@@ -2325,14 +3458,20 @@ var translations = map[string]string{
     "menu.sessions": "会话",
     "menu.users": "用户",
     "menu.about": "关于",
+    "menu.rules": "规则",
     "menu.export": "导出",
     "menu.import": "导入",
     "menu.create_category": "新建分类",
+    "menu.create_rule": "创建规则",
+    "menu.site_rules": "站点规则",
+    "menu.create_site_rule": "新建站点规则",
     "menu.mark_page_as_read": "标记为已读",
     "menu.mark_all_as_read": "全部标为已读",
     "menu.mark_all_as_read_wip": "执行中…",
     "menu.show_all_entries": "显示所有条目",
     "menu.show_only_unread_entries": "仅显示未读文章",
+    "menu.show_only_short_reads": "仅显示短文（5 分钟以内）",
+    "menu.show_all_reading_times": "显示所有阅读时长的文章",
     "menu.refresh_feed": "更新",
     "menu.refresh_all_feeds": "在后台更新全部源",
     "menu.edit_feed": "编辑",
@@ -2360,13 +3499,54 @@ var translations = map[string]string{
     "entry.original.label": "原始内容",
     "entry.comments.label": "评论",
     "entry.comments.title": "查看评论",
+    "entry.estimated_reading_time": [
+        "需要 %d 分钟阅读"
+    ],
+    "entry.revisions.label": "修改",
+    "entry.podcast.season": "第 %d 季",
+    "entry.podcast.episode": "第 %d 集",
+    "entry.podcast.explicit": "含露骨内容",
+    "entry.media.playback_speed": "播放速度",
+    "entry.revisions.title": "查看此文章的修改",
+    "entry.updated": "已更新",
     "page.unread.title": "未读",
     "page.starred.title": "星标",
     "page.categories.title": "分类",
+    "page.tags.title": "标签",
+    "page.tags.entry_count": [
+        "有 %d 篇文章"
+    ],
     "page.categories.no_feed": "没有源",
     "page.categories.feed_count": [
         "有 %d 个源"
     ],
+    "page.rules.title": "规则",
+    "page.new_rule.title": "新规则",
+    "page.edit_rule.title": "编辑规则：%s",
+    "page.rules.feed": "源：%s",
+    "page.rules.category": "分类：%s",
+    "page.rules.title_pattern": "标题：%s",
+    "page.rules.content_pattern": "内容：%s",
+    "page.rules.author_pattern": "作者：%s",
+    "page.rules.older_than": [
+        "超过 %d 天"
+    ],
+    "page.rules.match_count": [
+        "%d 篇匹配的文章"
+    ],
+    "page.rules.apply": "应用于现有文章",
+    "page.site_rules.title": "站点规则",
+    "page.new_site_rule.title": "新站点规则",
+    "page.edit_site_rule.title": "编辑站点规则：%s",
+    "page.site_rules.help": "当订阅源没有定义自己的抓取或重写规则时，将使用站点规则。您的规则优先于与所有用户共享的规则。禁用的规则会关闭该域名的预定义规则。",
+    "page.site_rules.global": "与所有用户共享",
+    "page.site_rules.private": "私有",
+    "page.site_rules.disabled": "已禁用",
+    "page.site_rules.scraper_rules": "抓取规则：%s",
+    "page.site_rules.rewrite_rules": "重写规则：%s",
+    "page.site_rules.builtin": "内置规则",
+    "page.site_rules.override": "覆盖",
+    "page.site_rules.global_help": "此规则与所有用户共享。",
     "page.new_category.title": "新分类",
     "page.new_user.title": "新用户",
     "page.edit_category.title": "编辑分类 : %s",
@@ -2376,6 +3556,8 @@ var translations = map[string]string{
     "page.feeds.error_count": [
         "%d 错误"
     ],
+    "page.feeds.next_retry": "下次重试：",
+    "page.feeds.disabled": "已禁用",
     "page.history.title": "历史",
     "page.import.title": "导入",
     "page.search.title": "搜索结果",
@@ -2393,11 +3575,29 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "选择一个订阅",
     "page.edit_feed.title": "编辑源 : %s",
     "page.edit_feed.last_check": "最后检查时间：",
+    "page.edit_feed.next_check": "下次检查时间：",
+    "page.edit_feed.websub_hub": "WebSub 中心：",
+    "page.edit_feed.websub_lease": "更新推送至 %s",
     "page.edit_feed.last_modified_header": "最后修改的 Header：",
     "page.edit_feed.etag_header": "ETag 标题：",
     "page.edit_feed.no_header": "无",
     "page.edit_feed.last_parsing_error": "最后一次解析错误",
+    "page.edit_feed.fetch_history": "抓取历史",
+    "page.edit_feed.fetch_history.date": "日期",
+    "page.edit_feed.fetch_history.status": "HTTP 状态",
+    "page.edit_feed.fetch_history.duration": "耗时",
+    "page.edit_feed.fetch_history.size": "大小（字节）",
+    "page.edit_feed.fetch_history.entries": "文章",
+    "page.edit_feed.fetch_history.entries_count": "新增 %d，更新 %d",
+    "page.edit_feed.fetch_history.error": "错误",
+    "page.edit_feed.fetch_history.not_modified": "未修改",
+    "page.edit_feed.fetch_history.moved": "已从 %s 移动到 %s",
     "page.entry.attachments": "附件",
+    "page.entry.duplicates": "同时发布于",
+    "page.entry.tags": "标签",
+    "page.entry_revisions.title": "文章修改记录",
+    "page.entry_revisions.changed_at": "修改于",
+    "page.entry_revisions.same_content": "内容没有变化。",
     "page.keyboard_shortcuts.title": "快捷键",
     "page.keyboard_shortcuts.subtitle.sections": "分区导航",
     "page.keyboard_shortcuts.subtitle.items": "条目导航",
@@ -2455,11 +3655,19 @@ var translations = map[string]string{
     "page.sessions.table.actions": "操作",
     "page.sessions.table.current_session": "当前会话",
     "alert.no_bookmark": "目前没有书签",
+    "alert.no_tag": "目前没有标签",
+    "alert.no_tag_entry": "没有带此标签的文章",
     "alert.no_category": "目前没有分类",
     "alert.no_category_entry": "该分类下没有文章",
     "alert.no_feed_entry": "该源中没有文章",
     "alert.no_feed": "目前没有订阅",
     "alert.no_history": "目前没有历史",
+    "alert.no_revision": "此文章没有以前的版本。",
+    "alert.no_rule": "没有规则。",
+    "alert.no_site_rule": "没有站点规则。",
+    "alert.rule_applied": [
+        "规则匹配了 %d 篇文章。"
+    ],
     "alert.feed_error": "该源存在问题",
     "alert.no_search_result": "该搜索没有结果",
     "alert.no_unread_entry": "目前没有未读文章",
@@ -2484,6 +3692,23 @@ var translations = map[string]string{
     "error.empty_file": "该文件为空",
     "error.bad_credentials": "用户名或密码无效",
     "error.fields_mandatory": "必须填写全部信息",
+    "error.invalid_proxy_url": "代理 URL 无效，仅支持 http、https 和 socks5 代理",
+    "error.invalid_duplicate_policy": "无效的重复文章规则。",
+    "error.invalid_filter_rules": "无效的过滤规则",
+    "error.rule_condition_required": "规则必须至少有一个条件。",
+    "error.rule_action_required": "规则必须至少有一个操作。",
+    "error.rule_condition_not_found": "所选的源或分类不存在。",
+    "error.invalid_rule_age": "文章的时间必须是正数天。",
+    "error.invalid_rule_pattern": "无效的正则表达式：%s",
+    "error.unable_to_create_rule": "无法创建此规则",
+    "error.unable_to_update_rule": "无法更新此规则",
+    "error.domain_required": "必须填写域名。",
+    "error.invalid_domain": "无效的域名 %q，只需填写主机名（例如：example.org）。",
+    "error.site_rule_already_exists": "此域名已存在站点规则。",
+    "error.unable_to_create_site_rule": "无法创建此站点规则。",
+    "error.unable_to_update_site_rule": "无法更新此站点规则。",
+    "error.invalid_headers": "自定义请求头或 Cookie 无效",
+    "error.encryption_key_missing": "未配置加密密钥（ENCRYPTION_KEY），无法保存自定义请求头和 Cookie",
     "error.title_required": "必须填写标题",
     "error.different_passwords": "两次输入的密码不同",
     "error.password_min_length": "请至少使用6个字符",
@@ -2491,15 +3716,51 @@ var translations = map[string]string{
     "error.feed_mandatory_fields": "必须填写 URL 和分类",
     "error.user_mandatory_fields": "必须填写用户名",
     "form.feed.label.title": "标题",
+    "form.entry.label.tags": "标签（以逗号分隔）",
     "form.feed.label.site_url": "站点 URL",
     "form.feed.label.feed_url": "源 URL",
     "form.feed.label.category": "类别",
     "form.feed.label.crawler": "获取原始内容",
+    "form.feed.label.mark_unread_on_update": "将更新的文章标记为未读",
+    "form.feed.label.keep_tracking_parameters": "保留链接中的跟踪参数（utm_source、fbclid...）",
+    "form.feed.label.resolve_final_url": "跟随文章链接的重定向以保存最终网址（FeedBurner、短链接服务...）",
+    "form.feed.label.disabled": "不要刷新此源",
     "form.feed.label.feed_username": "源用户名",
     "form.feed.label.feed_password": "源密码",
     "form.feed.label.user_agent": "覆盖默认 User-Agent",
+    "form.feed.label.proxy_url": "代理 URL（http、https 或 socks5）",
+    "form.feed.label.headers": "自定义请求头（每行一个 \"名称: 值\"）",
+    "form.feed.label.cookie": "Cookie",
     "form.feed.label.scraper_rules": "Scraper 规则",
     "form.feed.label.rewrite_rules": "重写规则",
+    "form.feed.label.keep_rules": "仅保留匹配这些规则的文章",
+    "form.feed.label.block_rules": "屏蔽匹配这些规则的文章",
+    "form.feed.help.filter_rules": "每行一个正则表达式，可选字段前缀：title、content、author、url 或 category。",
+    "form.feed.help.scraper_rules": "内容的 CSS 选择器，可选地后跟 \"!\" 和要删除的元素的 CSS 选择器。",
+    "form.feed.help.rewrite_rules": "以逗号分隔的规则：add_image_title, add_dynamic_image, add_youtube_video, add_castopod_episode, convert_text_link, nl2br, replace(\"regex\"|\"替换\"), replace_title(\"regex\"|\"替换\"), remove(\"CSS 选择器\") 和 base64_decode(\"CSS 选择器\")。",
+    "form.feed.help.undecryptable_headers": "无法使用当前的加密密钥解密已保存的请求头或 Cookie，它们不会被发送，并将在保存此表单时被表单中的值替换。",
+    "form.feed.label.filter_action": "被屏蔽的文章",
+    "form.feed.select.filter_drop": "忽略",
+    "form.feed.select.filter_read": "保存为已读",
+    "form.rule.label.title": "标题",
+    "form.rule.section.conditions": "条件",
+    "form.rule.section.actions": "操作",
+    "form.rule.label.feed": "源",
+    "form.rule.select.any_feed": "任意源",
+    "form.rule.label.category": "分类",
+    "form.rule.select.any_category": "任意分类",
+    "form.rule.label.title_pattern": "标题匹配",
+    "form.rule.label.content_pattern": "内容匹配",
+    "form.rule.label.author_pattern": "作者匹配",
+    "form.rule.label.older_than": "发布于 N 天之前",
+    "form.rule.help.conditions": "文章必须满足所有条件，空条件将被忽略。模式为正则表达式。",
+    "form.rule.label.mark_as_read": "标记为已读",
+    "form.rule.label.star": "加星标",
+    "form.rule.label.send_to_integrations": "发送到第三方服务",
+    "form.rule.label.tag": "添加标签",
+    "form.site_rule.label.domain": "域名",
+    "form.site_rule.label.disabled": "禁用此域名的预定义规则",
+    "form.site_rule.label.global": "与所有用户共享此规则",
     "form.category.label.title": "标题",
     "form.user.label.username": "用户名",
     "form.user.label.password": "密码",
@@ -2512,6 +3773,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "旧->新",
     "form.prefs.select.recent_first": "新->旧",
     "form.prefs.label.keyboard_shortcuts": "启用键盘快捷键",
+    "form.prefs.label.duplicate_policy": "已由其他源发布的文章",
+    "form.prefs.select.duplicate_none": "保持未读",
+    "form.prefs.select.duplicate_read": "标记为已读",
+    "form.prefs.select.duplicate_group": "归入第一篇文章",
+    "form.prefs.label.duplicate_match_title": "同时通过标题检测重复文章",
+    "form.prefs.label.mark_read_on_media_completion": "仅在播放结束后将包含播客或视频的文章标记为已读",
     "form.import.label.file": "OPML 文件",
     "form.integration.fever_activate": "启用 Fever API",
     "form.integration.fever_username": "Fever 用户名",
@@ -2583,13 +3850,13 @@ var translations = map[string]string{
 }
 
 var translationsChecksums = map[string]string{
-	"de_DE": "24812dcba285e56e042486c7f3d6fd9b915b37dc8473640d5099bade94e702b2",
-	"en_US": "491b3765c7e7b1a3e49b265bf3358a48b6d9aeea5340777a38957ad216101b9d",
-	"es_ES": "89b84d2505fc27d3f75b2622eae78373c6ce465dc180e1e5bf2a4aca25f73f2e",
-	"fr_FR": "ac80056831e39c48b47d54299ff112fd9f5e35d14fa248b6a5ae049045cf1537",
-	"it_IT": "b3521ffb2f56810568bc2317846f2dd16dad77b76dadec5990598af2a6e49403",
-	"nl_NL": "7d095d9c8915e7ae79d28d35793cb6fce04c867e35b9b4a956da45a7f1a0925c",
-	"pl_PL": "d99b8dcf56f5672e261b231f01cdf5e17f9c3aa422c798f994aa480a1d9a92e6",
-	"ru_RU": "4c135a56164be9223d87c50d738d8096c9e732c8ec51ee4a7c7db09ee634837a",
-	"zh_CN": "c1481025b98c282b284aae12e61d5f2356f4d83797d25a596afb67c3e170c6bf",
+	"de_DE": "30378ea88b6092c4dced55aa8455dc303276706328f79a5ec4e1a24d77e5168e",
+	"en_US": "54f083f3cc7822a911526578a43ef2689c7cfa26e6734d30456944b8c3aeb1d0",
+	"es_ES": "c19ad175c6a98a2b6c4090b8dad2d06cb95d50c3a69b54850b34a2ec4481060c",
+	"fr_FR": "d19e5699bc7c3eaa70aae1666ff42b349d5a4e34a45e8c0a0116d72c386891cf",
+	"it_IT": "fbc6a8a6b13ee87f616db2b0e587caf5904bf13d0ed3a41d2e8a98bbe312c666",
+	"nl_NL": "bfea883f965ff2c0aa2e45ce9336d10959d364ba144e20e61dc2c0ff402a778b",
+	"pl_PL": "b25993318c6a647741136212809900a9d763b735280bd3182e771989f627c543",
+	"ru_RU": "2b00cff9f60b3c19f5e477e986c024a09deaa4554465daad0a19b61b759d3650",
+	"zh_CN": "485fcefbba0c499bf5ace2ff0d95e52d8b13c10dcfc6d54c833e787ff489a236",
 }
@@ -4,15 +4,44 @@
 
 package model // import "miniflux.app/model"
 
+import "strings"
+
 // Enclosure represents an attachment.
 type Enclosure struct {
-	ID       int64  `json:"id"`
-	UserID   int64  `json:"user_id"`
-	EntryID  int64  `json:"entry_id"`
-	URL      string `json:"url"`
-	MimeType string `json:"mime_type"`
-	Size     int64  `json:"size"`
+	ID               int64  `json:"id"`
+	UserID           int64  `json:"user_id"`
+	EntryID          int64  `json:"entry_id"`
+	URL              string `json:"url"`
+	MimeType         string `json:"mime_type"`
+	Size             int64  `json:"size"`
+	Duration         int    `json:"duration"`
+	MediaProgression int    `json:"media_progression"`
+}
+
+// IsAudioOrVideo returns true if the attachment can be played by the media player.
+func (e *Enclosure) IsAudioOrVideo() bool {
+	return strings.HasPrefix(e.MimeType, "audio/") || strings.HasPrefix(e.MimeType, "video/")
 }
 
 // EnclosureList represents a list of attachments.
 type EnclosureList []*Enclosure
+
+// Contains returns true if the list contains an enclosure with the given URL.
+func (el EnclosureList) Contains(url string) bool {
+	for _, enclosure := range el {
+		if enclosure.URL == url {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAudioOrVideo returns true if one of the attachments can be played by the media player.
+func (el EnclosureList) ContainsAudioOrVideo() bool {
+	for _, enclosure := range el {
+		if enclosure.IsAudioOrVideo() {
+			return true
+		}
+	}
+	return false
+}
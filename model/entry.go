@@ -6,7 +6,9 @@ package model // import "miniflux.app/model"
 
 import (
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 )
 
 // Entry statuses
@@ -20,26 +22,77 @@ const (
 
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Date        time.Time     `json:"published_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	Starred     bool          `json:"starred"`
-	Enclosures  EnclosureList `json:"enclosures,omitempty"`
-	Feed        *Feed         `json:"feed,omitempty"`
-	Category    *Category     `json:"category,omitempty"`
+	ID           int64         `json:"id"`
+	UserID       int64         `json:"user_id"`
+	FeedID       int64         `json:"feed_id"`
+	Status       string        `json:"status"`
+	Hash         string        `json:"hash"`
+	Title        string        `json:"title"`
+	URL          string        `json:"url"`
+	CommentsURL  string        `json:"comments_url"`
+	Date         time.Time     `json:"published_at"`
+	UpdatedAt    *time.Time    `json:"updated_at,omitempty"`
+	Content      string        `json:"content"`
+	Author       string        `json:"author"`
+	ReadingTime  int           `json:"reading_time"`
+	ThumbnailURL string        `json:"thumbnail_url"`
+	Subtitle     string        `json:"subtitle"`
+	Episode      int           `json:"episode"`
+	Season       int           `json:"season"`
+	Explicit     bool          `json:"explicit"`
+	Starred      bool          `json:"starred"`
+	CrawlPending bool          `json:"-"`
+	Categories   []string      `json:"-"`
+	Tags         Tags          `json:"tags"`
+	DuplicateOf  int64         `json:"duplicate_of_id,omitempty"`
+	Grouped      bool          `json:"-"`
+	Duplicates   Entries       `json:"duplicates,omitempty"`
+	Enclosures   EnclosureList `json:"enclosures,omitempty"`
+	Feed         *Feed         `json:"feed,omitempty"`
+	Category     *Category     `json:"category,omitempty"`
 }
 
 // Entries represents a list of entries.
 type Entries []*Entry
 
+// Copy returns a deep copy of the entries, so they can be modified without side effects.
+func (e Entries) Copy() Entries {
+	entries := make(Entries, 0, len(e))
+	for _, entry := range e {
+		entryCopy := *entry
+		entryCopy.Enclosures = make(EnclosureList, 0, len(entry.Enclosures))
+		for _, enclosure := range entry.Enclosures {
+			enclosureCopy := *enclosure
+			entryCopy.Enclosures = append(entryCopy.Enclosures, &enclosureCopy)
+		}
+		entries = append(entries, &entryCopy)
+	}
+	return entries
+}
+
+// AddCategories adds the categories given by the feed, blank and repeated values are ignored.
+func (e *Entry) AddCategories(categories ...string) {
+	for _, category := range categories {
+		e.Categories = appendTitle(e.Categories, category)
+	}
+}
+
+// ShouldMarkAsReadOnView returns false when the user prefers to mark the entries with a podcast or a video as read
+// once the media player reaches the end.
+func (e *Entry) ShouldMarkAsReadOnView(user *User) bool {
+	return !user.MarkReadOnMediaCompletion || !e.Enclosures.ContainsAudioOrVideo()
+}
+
+// NormalizeTitle returns a simplified form of the given title, used to find the same story published by several feeds.
+// The title is lowercased, punctuation is removed and whitespaces are collapsed.
+func NormalizeTitle(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	return strings.Join(words, " ")
+}
+
 // ValidateEntryStatus makes sure the entry status is valid.
 func ValidateEntryStatus(status string) error {
 	switch status {
@@ -53,11 +106,11 @@ func ValidateEntryStatus(status string) error {
 // ValidateEntryOrder makes sure the sorting order is valid.
 func ValidateEntryOrder(order string) error {
 	switch order {
-	case "id", "status", "published_at", "category_title", "category_id":
+	case "id", "status", "published_at", "category_title", "category_id", "reading_time":
 		return nil
 	}
 
-	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "published_at", "category_title", "category_id"`)
+	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "published_at", "category_title", "category_id", "reading_time"`)
 }
 
 // ValidateDirection makes sure the sorting direction is valid.
@@ -0,0 +1,60 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// EntryRevision represents a previous version of an entry, saved when the feed updated the entry.
+type EntryRevision struct {
+	ID        int64     `json:"id"`
+	EntryID   int64     `json:"entry_id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EntryRevisions represents a list of entry revisions.
+type EntryRevisions []*EntryRevision
+
+// IsRevised returns true if the title or the text of the content have changed.
+// Changes limited to the markup or to the whitespaces are ignored.
+func IsRevised(oldTitle, oldContent, title, content string) bool {
+	normalize := func(text string) string {
+		return strings.Join(strings.Fields(text), " ")
+	}
+
+	if normalize(oldTitle) != normalize(title) {
+		return true
+	}
+
+	return normalize(textContent(oldContent)) != normalize(textContent(content))
+}
+
+// textContent returns the text of an HTML document without the markup.
+// The whole document is returned when it cannot be parsed.
+func textContent(document string) string {
+	tokenizer := html.NewTokenizer(bytes.NewBufferString(document))
+	var buffer bytes.Buffer
+
+	for {
+		if tokenizer.Next() == html.ErrorToken {
+			if tokenizer.Err() == io.EOF {
+				return buffer.String()
+			}
+			return document
+		}
+
+		if token := tokenizer.Token(); token.Type == html.TextToken {
+			buffer.WriteString(token.Data)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestIsRevised(t *testing.T) {
+	scenarios := []struct {
+		oldTitle, oldContent, title, content string
+		expected                             bool
+	}{
+		{"Title", "<p>Some text</p>", "Title", "<p>Some text</p>", false},
+		{"Title", "<p>Some text</p>", " Title ", "<div>Some\n  text</div>", false},
+		{"Title", "<p>Some text</p>", "New title", "<p>Some text</p>", true},
+		{"Title", "<p>Some text</p>", "Title", "<p>Some other text</p>", true},
+	}
+
+	for _, scenario := range scenarios {
+		result := IsRevised(scenario.oldTitle, scenario.oldContent, scenario.title, scenario.content)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected result for %q -> %q, got %v instead of %v`, scenario.oldContent, scenario.content, result, scenario.expected)
+		}
+	}
+}
@@ -19,7 +19,7 @@ func TestValidateEntryStatus(t *testing.T) {
 }
 
 func TestValidateEntryOrder(t *testing.T) {
-	for _, status := range []string{"id", "status", "published_at", "category_title", "category_id"} {
+	for _, status := range []string{"id", "status", "published_at", "category_title", "category_id", "reading_time"} {
 		if err := ValidateEntryOrder(status); err != nil {
 			t.Error(`A valid order should not generate any error`)
 		}
@@ -69,3 +69,62 @@ func TestGetOppositeDirection(t *testing.T) {
 		t.Errorf(`An invalid direction should return "asc"`)
 	}
 }
+
+func TestEntriesCopy(t *testing.T) {
+	entries := Entries{
+		&Entry{Title: "Title", Content: "Content", Enclosures: EnclosureList{&Enclosure{URL: "http://example.org/file.mp3"}}},
+	}
+
+	entriesCopy := entries.Copy()
+	entriesCopy[0].Content = "Modified"
+	entriesCopy[0].Enclosures[0].URL = "http://example.org/other.mp3"
+
+	if entries[0].Content != "Content" {
+		t.Errorf(`The original entry should not be modified`)
+	}
+
+	if entries[0].Enclosures[0].URL != "http://example.org/file.mp3" {
+		t.Errorf(`The original enclosure should not be modified`)
+	}
+
+	if entriesCopy[0].Title != "Title" {
+		t.Errorf(`The entry should be copied`)
+	}
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	scenarios := map[string]string{
+		"Hello World":         "hello world",
+		"  Hello,   World!  ": "hello world",
+		"Go 1.12 is released": "go 1 12 is released",
+		"L'été – « Paris »":   "l été paris",
+		"¿Qué?":               "qué",
+		"":                    "",
+	}
+
+	for input, expected := range scenarios {
+		actual := NormalizeTitle(input)
+		if actual != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
+
+func TestShouldMarkAsReadOnView(t *testing.T) {
+	podcast := &Entry{Enclosures: EnclosureList{&Enclosure{MimeType: "audio/mpeg"}}}
+	article := &Entry{Enclosures: EnclosureList{&Enclosure{MimeType: "image/png"}}}
+
+	user := &User{MarkReadOnMediaCompletion: false}
+	if !podcast.ShouldMarkAsReadOnView(user) || !article.ShouldMarkAsReadOnView(user) {
+		t.Error(`The entries should be marked as read when the setting is disabled`)
+	}
+
+	user.MarkReadOnMediaCompletion = true
+	if podcast.ShouldMarkAsReadOnView(user) {
+		t.Error(`An entry with a podcast should not be marked as read when the setting is enabled`)
+	}
+
+	if !article.ShouldMarkAsReadOnView(user) {
+		t.Error(`An entry without audio or video should be marked as read`)
+	}
+}
@@ -8,30 +8,56 @@ import (
 	"fmt"
 	"time"
 
+	"miniflux.app/config"
 	"miniflux.app/http/client"
 )
 
+// Actions applied to the entries blocked by the filter rules of a feed.
+const (
+	FilterActionDrop = "drop"
+	FilterActionRead = "read"
+)
+
 // Feed represents a feed in the application.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at"`
-	EtagHeader         string    `json:"etag_header"`
-	LastModifiedHeader string    `json:"last_modified_header"`
-	ParsingErrorMsg    string    `json:"parsing_error_message"`
-	ParsingErrorCount  int       `json:"parsing_error_count"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Category           *Category `json:"category,omitempty"`
-	Entries            Entries   `json:"entries,omitempty"`
-	Icon               *FeedIcon `json:"icon"`
+	ID                     int64             `json:"id"`
+	UserID                 int64             `json:"user_id"`
+	FeedURL                string            `json:"feed_url"`
+	SiteURL                string            `json:"site_url"`
+	Title                  string            `json:"title"`
+	CheckedAt              time.Time         `json:"checked_at"`
+	NextCheckAt            time.Time         `json:"next_check_at"`
+	EtagHeader             string            `json:"etag_header"`
+	LastModifiedHeader     string            `json:"last_modified_header"`
+	ParsingErrorMsg        string            `json:"parsing_error_message"`
+	ParsingErrorCount      int               `json:"parsing_error_count"`
+	TTL                    int               `json:"ttl"`
+	HubURL                 string            `json:"hub_url"`
+	HubTopicURL            string            `json:"hub_topic_url"`
+	HubSecret              string            `json:"-"`
+	HubLeaseExpiresAt      time.Time         `json:"hub_lease_expires_at"`
+	NewFeedURL             string            `json:"-"`
+	ScraperRules           string            `json:"scraper_rules"`
+	RewriteRules           string            `json:"rewrite_rules"`
+	KeepRules              string            `json:"keep_rules"`
+	BlockRules             string            `json:"block_rules"`
+	FilterAction           string            `json:"filter_action"`
+	Crawler                bool              `json:"crawler"`
+	MarkUnreadOnUpdate     bool              `json:"mark_unread_on_update"`
+	KeepTrackingParameters bool              `json:"keep_tracking_parameters"`
+	ResolveFinalURL        bool              `json:"resolve_final_url"`
+	Disabled               bool              `json:"disabled"`
+	UserAgent              string            `json:"user_agent"`
+	Username               string            `json:"username"`
+	Password               string            `json:"password"`
+	ProxyURL               string            `json:"proxy_url"`
+	Headers                map[string]string `json:"headers"`
+	Cookie                 string            `json:"cookie"`
+	EncryptedHeaders       string            `json:"-"`
+	EncryptedCookie        string            `json:"-"`
+	Category               *Category         `json:"category,omitempty"`
+	Entries                Entries           `json:"entries,omitempty"`
+	Icon                   *FeedIcon         `json:"icon"`
 }
 
 func (f *Feed) String() string {
@@ -45,11 +71,22 @@ func (f *Feed) String() string {
 	)
 }
 
+// ValidateFilterAction makes sure the action applied to blocked entries is valid.
+func ValidateFilterAction(action string) error {
+	switch action {
+	case FilterActionDrop, FilterActionRead:
+		return nil
+	}
+
+	return fmt.Errorf(`Invalid filter action, valid values are: "%s" and "%s"`, FilterActionDrop, FilterActionRead)
+}
+
 // WithClientResponse updates feed attributes from an HTTP request.
+// The feed URL changes only when the server replied with a permanent redirect.
 func (f *Feed) WithClientResponse(response *client.Response) {
 	f.EtagHeader = response.ETag
 	f.LastModifiedHeader = response.LastModified
-	f.FeedURL = response.EffectiveURL
+	f.FeedURL = response.PermanentURL()
 }
 
 // WithCategoryID initializes the category attribute of the feed.
@@ -58,11 +95,18 @@ func (f *Feed) WithCategoryID(categoryID int64) {
 }
 
 // WithBrowsingParameters defines browsing parameters.
-func (f *Feed) WithBrowsingParameters(crawler bool, userAgent, username, password string) {
+func (f *Feed) WithBrowsingParameters(crawler bool, userAgent, username, password, proxyURL string) {
 	f.Crawler = crawler
 	f.UserAgent = userAgent
 	f.Username = username
 	f.Password = password
+	f.ProxyURL = proxyURL
+}
+
+// WithRequestHeaders defines the custom headers and the cookie sent when fetching the feed.
+func (f *Feed) WithRequestHeaders(headers map[string]string, cookie string) {
+	f.Headers = headers
+	f.Cookie = cookie
 }
 
 // WithError adds a new error message and increment the error counter.
@@ -86,5 +130,86 @@ func (f *Feed) CheckedNow() {
 	}
 }
 
+// ScheduleNextCheck computes the next time the feed should be refreshed.
+//
+// The interval is the average delay between the entries published during the last week.
+// The feed is never polled more often than the publisher asks for (TTL or HTTP caching headers),
+// and the interval is always kept within the limits defined in the configuration.
+// Feeds pushed by a WebSub hub are polled with the maximum interval.
+func (f *Feed) ScheduleNextCheck(weeklyCount int, cacheMaxAge time.Duration) {
+	minInterval := time.Duration(config.Opts.SchedulerMinInterval()) * time.Minute
+	maxInterval := time.Duration(config.Opts.SchedulerMaxInterval()) * time.Minute
+
+	interval := maxInterval
+	if weeklyCount > 0 {
+		interval = 7 * 24 * time.Hour / time.Duration(weeklyCount)
+	}
+
+	if ttl := time.Duration(f.TTL) * time.Minute; ttl > interval {
+		interval = ttl
+	}
+
+	if cacheMaxAge > interval {
+		interval = cacheMaxAge
+	}
+
+	if f.HasWebSubLease() {
+		interval = maxInterval
+	}
+
+	if interval < minInterval {
+		interval = minInterval
+	}
+
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	f.NextCheckAt = f.CheckedAt.Add(interval)
+}
+
+// ScheduleNextRetry delays the next refresh of a failing feed.
+//
+// The delay doubles after each consecutive error, starting at one hour and capped
+// at the maximum scheduler interval. A Retry-After delay sent by the server is honored
+// when it is longer, within the same limit.
+func (f *Feed) ScheduleNextRetry(retryAfter time.Duration) {
+	maxInterval := time.Duration(config.Opts.SchedulerMaxInterval()) * time.Minute
+
+	interval := time.Hour
+	for i := 1; i < f.ParsingErrorCount && interval < maxInterval; i++ {
+		interval *= 2
+	}
+
+	if retryAfter > interval {
+		interval = retryAfter
+	}
+
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	f.NextCheckAt = f.CheckedAt.Add(interval)
+}
+
+// WebSubTopicURL returns the topic to use when subscribing to the hub.
+func (f *Feed) WebSubTopicURL() string {
+	if f.HubTopicURL != "" {
+		return f.HubTopicURL
+	}
+	return f.FeedURL
+}
+
+// HasWebSubLease returns true if the hub is currently pushing updates of this feed.
+func (f *Feed) HasWebSubLease() bool {
+	return f.HubURL != "" && f.HubLeaseExpiresAt.After(time.Now())
+}
+
+// NeedsWebSubSubscription returns true if the feed should subscribe to its hub,
+// because there is no active lease or the lease expires before the next check.
+func (f *Feed) NeedsWebSubSubscription() bool {
+	return f.HubURL != "" && f.HubLeaseExpiresAt.Before(f.NextCheckAt.Add(time.Hour))
+}
+
 // Feeds is a list of feed
 type Feeds []*Feed
@@ -0,0 +1,47 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"time"
+
+	"miniflux.app/http/client"
+)
+
+// FeedFetch represents one refresh of a feed, recorded to troubleshoot misbehaving feeds.
+type FeedFetch struct {
+	ID             int64     `json:"id"`
+	FeedID         int64     `json:"feed_id"`
+	FetchedAt      time.Time `json:"fetched_at"`
+	StatusCode     int       `json:"status_code"`
+	Duration       int       `json:"duration"`
+	Size           int64     `json:"size"`
+	NotModified    bool      `json:"not_modified"`
+	CreatedEntries int       `json:"created_entries"`
+	UpdatedEntries int       `json:"updated_entries"`
+	Error          string    `json:"error"`
+	PreviousURL    string    `json:"previous_url"`
+	NewURL         string    `json:"new_url"`
+}
+
+// NewFeedFetch returns the record of a refresh, the duration is stored in milliseconds.
+// The response is nil when the server could not be reached.
+func NewFeedFetch(feedID int64, response *client.Response, duration time.Duration) *FeedFetch {
+	fetch := &FeedFetch{
+		FeedID:    feedID,
+		FetchedAt: time.Now(),
+		Duration:  int(duration / time.Millisecond),
+	}
+
+	if response != nil {
+		fetch.StatusCode = response.StatusCode
+		fetch.Size = response.BodySize
+	}
+
+	return fetch
+}
+
+// FeedFetches represents the refresh history of a feed.
+type FeedFetches []*FeedFetch
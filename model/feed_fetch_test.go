@@ -0,0 +1,41 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/http/client"
+)
+
+func TestNewFeedFetch(t *testing.T) {
+	response := &client.Response{StatusCode: 200, BodySize: 1024}
+	fetch := NewFeedFetch(42, response, 1500*time.Millisecond)
+
+	if fetch.FeedID != 42 {
+		t.Errorf(`Unexpected feed ID, got %d`, fetch.FeedID)
+	}
+
+	if fetch.StatusCode != 200 || fetch.Size != 1024 {
+		t.Errorf(`The response should be recorded, got status %d and size %d`, fetch.StatusCode, fetch.Size)
+	}
+
+	if fetch.Duration != 1500 {
+		t.Errorf(`The duration should be in milliseconds, got %d`, fetch.Duration)
+	}
+
+	if fetch.FetchedAt.IsZero() {
+		t.Error(`The fetch date must be set`)
+	}
+}
+
+func TestNewFeedFetchWithoutResponse(t *testing.T) {
+	fetch := NewFeedFetch(42, nil, time.Second)
+
+	if fetch.StatusCode != 0 || fetch.Size != 0 {
+		t.Errorf(`Unexpected status %d and size %d`, fetch.StatusCode, fetch.Size)
+	}
+}
@@ -5,8 +5,11 @@
 package model // import "miniflux.app/model"
 
 import (
+	"os"
 	"testing"
+	"time"
 
+	"miniflux.app/config"
 	"miniflux.app/http/client"
 )
 
@@ -44,7 +47,7 @@ func TestFeedCategorySetter(t *testing.T) {
 
 func TestFeedBrowsingParams(t *testing.T) {
 	feed := &Feed{}
-	feed.WithBrowsingParameters(true, "Custom User Agent", "Username", "Secret")
+	feed.WithBrowsingParameters(true, "Custom User Agent", "Username", "Secret", "http://proxy.example.org:3128")
 
 	if !feed.Crawler {
 		t.Error(`The crawler must be activated`)
@@ -61,6 +64,23 @@ func TestFeedBrowsingParams(t *testing.T) {
 	if feed.Password != "Secret" {
 		t.Error(`The password must be set`)
 	}
+
+	if feed.ProxyURL != "http://proxy.example.org:3128" {
+		t.Error(`The proxy URL must be set`)
+	}
+}
+
+func TestFeedRequestHeaders(t *testing.T) {
+	feed := &Feed{}
+	feed.WithRequestHeaders(map[string]string{"Authorization": "Bearer token"}, "session=abc")
+
+	if feed.Headers["Authorization"] != "Bearer token" {
+		t.Error(`The custom headers must be set`)
+	}
+
+	if feed.Cookie != "session=abc" {
+		t.Error(`The cookie must be set`)
+	}
 }
 
 func TestFeedErrorCounter(t *testing.T) {
@@ -99,3 +119,183 @@ func TestFeedCheckedNow(t *testing.T) {
 		t.Error(`The checked date must be set`)
 	}
 }
+
+func parseDefaultConfig(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
+
+func TestFeedScheduleNextCheckWithEntryFrequency(t *testing.T) {
+	parseDefaultConfig(t)
+
+	feed := &Feed{}
+	feed.CheckedNow()
+	feed.ScheduleNextCheck(7*24, 0)
+
+	if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != time.Hour {
+		t.Errorf(`Unexpected interval, got %v instead of %v`, interval, time.Hour)
+	}
+}
+
+func TestFeedScheduleNextCheckWithoutRecentEntries(t *testing.T) {
+	parseDefaultConfig(t)
+
+	feed := &Feed{}
+	feed.CheckedNow()
+	feed.ScheduleNextCheck(0, 0)
+
+	expected := time.Duration(config.Opts.SchedulerMaxInterval()) * time.Minute
+	if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != expected {
+		t.Errorf(`Unexpected interval, got %v instead of %v`, interval, expected)
+	}
+}
+
+func TestFeedScheduleNextCheckWithMinInterval(t *testing.T) {
+	parseDefaultConfig(t)
+
+	feed := &Feed{}
+	feed.CheckedNow()
+	feed.ScheduleNextCheck(100000, 0)
+
+	expected := time.Duration(config.Opts.SchedulerMinInterval()) * time.Minute
+	if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != expected {
+		t.Errorf(`Unexpected interval, got %v instead of %v`, interval, expected)
+	}
+}
+
+func TestFeedScheduleNextCheckWithTTL(t *testing.T) {
+	parseDefaultConfig(t)
+
+	feed := &Feed{TTL: 180}
+	feed.CheckedNow()
+	feed.ScheduleNextCheck(7*24, 0)
+
+	if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != 3*time.Hour {
+		t.Errorf(`Unexpected interval, got %v instead of %v`, interval, 3*time.Hour)
+	}
+}
+
+func TestFeedScheduleNextCheckWithCacheMaxAge(t *testing.T) {
+	parseDefaultConfig(t)
+
+	feed := &Feed{}
+	feed.CheckedNow()
+	feed.ScheduleNextCheck(7*24, 2*time.Hour)
+
+	if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != 2*time.Hour {
+		t.Errorf(`Unexpected interval, got %v instead of %v`, interval, 2*time.Hour)
+	}
+}
+
+func TestFeedScheduleNextRetryWithExponentialBackoff(t *testing.T) {
+	parseDefaultConfig(t)
+
+	scenarios := map[int]time.Duration{
+		1:  time.Hour,
+		2:  2 * time.Hour,
+		3:  4 * time.Hour,
+		4:  8 * time.Hour,
+		5:  16 * time.Hour,
+		6:  24 * time.Hour,
+		42: 24 * time.Hour,
+	}
+
+	for errorCount, expected := range scenarios {
+		feed := &Feed{ParsingErrorCount: errorCount}
+		feed.CheckedNow()
+		feed.ScheduleNextRetry(0)
+
+		if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != expected {
+			t.Errorf(`Unexpected interval for %d errors, got %v instead of %v`, errorCount, interval, expected)
+		}
+	}
+}
+
+func TestFeedScheduleNextRetryWithRetryAfter(t *testing.T) {
+	parseDefaultConfig(t)
+
+	feed := &Feed{ParsingErrorCount: 1}
+	feed.CheckedNow()
+	feed.ScheduleNextRetry(3 * time.Hour)
+
+	if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != 3*time.Hour {
+		t.Errorf(`Unexpected interval, got %v instead of %v`, interval, 3*time.Hour)
+	}
+
+	feed.ScheduleNextRetry(48 * time.Hour)
+	if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != 24*time.Hour {
+		t.Errorf(`Unexpected interval, got %v instead of %v`, interval, 24*time.Hour)
+	}
+}
+
+func TestFeedWithResponseAfterTemporaryRedirect(t *testing.T) {
+	response := &client.Response{
+		EffectiveURL: "https://cdn.example.org/feed.xml",
+		Redirects: []client.Redirect{
+			{URL: "https://example.org/feed.xml", StatusCode: 302, Location: "https://cdn.example.org/feed.xml"},
+		},
+	}
+
+	feed := &Feed{FeedURL: "https://example.org/feed.xml"}
+	feed.WithClientResponse(response)
+
+	if feed.FeedURL != "https://example.org/feed.xml" {
+		t.Errorf(`The feed URL should not change, got %q`, feed.FeedURL)
+	}
+}
+
+func TestFeedScheduleNextCheckWithWebSubLease(t *testing.T) {
+	parseDefaultConfig(t)
+
+	feed := &Feed{HubURL: "https://hub.example.org/", HubLeaseExpiresAt: time.Now().Add(10 * 24 * time.Hour)}
+	feed.CheckedNow()
+	feed.ScheduleNextCheck(7*24, 0)
+
+	expected := time.Duration(config.Opts.SchedulerMaxInterval()) * time.Minute
+	if interval := feed.NextCheckAt.Sub(feed.CheckedAt); interval != expected {
+		t.Errorf(`Unexpected interval, got %v instead of %v`, interval, expected)
+	}
+
+	if feed.NeedsWebSubSubscription() {
+		t.Error(`The lease should not be renewed yet`)
+	}
+}
+
+func TestFeedNeedsWebSubSubscription(t *testing.T) {
+	parseDefaultConfig(t)
+
+	feed := &Feed{}
+	feed.CheckedNow()
+	feed.ScheduleNextCheck(7*24, 0)
+	if feed.NeedsWebSubSubscription() {
+		t.Error(`A feed without hub should not subscribe`)
+	}
+
+	feed.HubURL = "https://hub.example.org/"
+	if !feed.NeedsWebSubSubscription() {
+		t.Error(`A feed without lease should subscribe`)
+	}
+
+	feed.HubLeaseExpiresAt = time.Now().Add(2 * time.Hour)
+	feed.ScheduleNextCheck(7*24, 0)
+	if !feed.NeedsWebSubSubscription() {
+		t.Error(`A lease expiring before the next check should be renewed`)
+	}
+}
+
+func TestFeedWebSubTopicURL(t *testing.T) {
+	feed := &Feed{FeedURL: "https://example.org/feed.xml"}
+	if feed.WebSubTopicURL() != "https://example.org/feed.xml" {
+		t.Errorf(`Unexpected topic URL, got %q`, feed.WebSubTopicURL())
+	}
+
+	feed.HubTopicURL = "https://example.org/self.xml"
+	if feed.WebSubTopicURL() != "https://example.org/self.xml" {
+		t.Errorf(`Unexpected topic URL, got %q`, feed.WebSubTopicURL())
+	}
+}
@@ -4,11 +4,52 @@
 
 package model // import "miniflux.app/model"
 
+import (
+	"strings"
+
+	"miniflux.app/url"
+)
+
 // Job represents a payload sent to the processing queue.
 type Job struct {
-	UserID int64
-	FeedID int64
+	UserID    int64
+	FeedID    int64
+	FeedURL   string
+	Username  string
+	Password  string
+	UserAgent string
+	ProxyURL  string
+	Headers   string
+	Cookie    string
+}
+
+// FetchKey returns a key that is identical for all the jobs that can share the same HTTP request.
+//
+// Custom headers and cookies are compared in their encrypted form, which is different
+// for each feed, so feeds using them never share their request.
+func (j Job) FetchKey() string {
+	return strings.Join([]string{url.Normalize(j.FeedURL), j.Username, j.Password, j.UserAgent, j.ProxyURL, j.Headers, j.Cookie}, "\x00")
 }
 
 // JobList represents a list of jobs.
 type JobList []Job
+
+// LimitFetches returns the jobs of the first distinct fetches of the list, at most limit fetches.
+// The jobs sharing the same fetch are all kept, even when they are not consecutive.
+func (l JobList) LimitFetches(limit int) JobList {
+	fetches := make(map[string]bool)
+	var jobs JobList
+
+	for _, job := range l {
+		key := job.FetchKey()
+		if !fetches[key] {
+			if len(fetches) >= limit {
+				continue
+			}
+			fetches[key] = true
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs
+}
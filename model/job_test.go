@@ -0,0 +1,53 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestJobFetchKey(t *testing.T) {
+	job := Job{FeedURL: "https://example.org/feed.xml"}
+
+	if job.FetchKey() != (Job{FeedURL: "HTTPS://Example.org:443/feed.xml"}).FetchKey() {
+		t.Error(`Equivalent URLs should have the same key`)
+	}
+
+	if job.FetchKey() == (Job{FeedURL: "https://example.org/feed.xml", Username: "alice", Password: "secret"}).FetchKey() {
+		t.Error(`Different credentials should have different keys`)
+	}
+
+	if job.FetchKey() == (Job{FeedURL: "https://example.org/feed.xml", UserAgent: "Custom"}).FetchKey() {
+		t.Error(`Different user agents should have different keys`)
+	}
+
+	if job.FetchKey() == (Job{FeedURL: "https://example.org/feed.xml", ProxyURL: "socks5://127.0.0.1:9050"}).FetchKey() {
+		t.Error(`Different proxies should have different keys`)
+	}
+
+	if job.FetchKey() == (Job{FeedURL: "https://example.org/feed.xml", Cookie: "encrypted cookie"}).FetchKey() {
+		t.Error(`Feeds with a cookie should have different keys`)
+	}
+}
+
+func TestJobListLimitFetches(t *testing.T) {
+	jobs := JobList{
+		{FeedID: 1, FeedURL: "https://example.org/feed.xml"},
+		{FeedID: 2, FeedURL: "https://example.com/feed.xml"},
+		{FeedID: 3, FeedURL: "HTTPS://Example.org:443/feed.xml"},
+		{FeedID: 4, FeedURL: "https://example.net/feed.xml"},
+	}
+
+	result := jobs.LimitFetches(1)
+	if len(result) != 2 || result[0].FeedID != 1 || result[1].FeedID != 3 {
+		t.Errorf(`The subscriptions sharing the first fetch should be kept together, got %+v`, result)
+	}
+
+	if result := jobs.LimitFetches(2); len(result) != 3 || result[1].FeedID != 2 {
+		t.Errorf(`Unexpected jobs for two fetches: %+v`, result)
+	}
+
+	if result := jobs.LimitFetches(10); len(result) != len(jobs) {
+		t.Errorf(`All the jobs should be kept, got %d jobs`, len(result))
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Rule represents an automation rule applied to the entries of a user.
+// An entry matches the rule when it satisfies all the conditions defined, the empty ones are ignored.
+type Rule struct {
+	ID                 int64  `json:"id"`
+	UserID             int64  `json:"user_id"`
+	Title              string `json:"title"`
+	FeedID             int64  `json:"feed_id"`
+	CategoryID         int64  `json:"category_id"`
+	TitlePattern       string `json:"title_pattern"`
+	ContentPattern     string `json:"content_pattern"`
+	AuthorPattern      string `json:"author_pattern"`
+	OlderThan          int    `json:"older_than"`
+	MarkAsRead         bool   `json:"mark_as_read"`
+	Star               bool   `json:"star"`
+	SendToIntegrations bool   `json:"send_to_integrations"`
+	Tag                string `json:"tag"`
+	MatchCount         int    `json:"match_count"`
+}
+
+// HasCondition returns true if at least one condition is defined.
+func (r Rule) HasCondition() bool {
+	return r.FeedID > 0 || r.CategoryID > 0 || r.TitlePattern != "" || r.ContentPattern != "" || r.AuthorPattern != "" || r.OlderThan > 0
+}
+
+// HasAction returns true if at least one action is defined.
+func (r Rule) HasAction() bool {
+	return r.MarkAsRead || r.Star || r.SendToIntegrations || r.Tag != ""
+}
+
+// ValidateRule validates rule fields.
+func (r Rule) ValidateRule() error {
+	if r.Title == "" {
+		return errors.New("The title is mandatory")
+	}
+
+	if !r.HasCondition() {
+		return errors.New("The rule must have at least one condition")
+	}
+
+	if !r.HasAction() {
+		return errors.New("The rule must have at least one action")
+	}
+
+	if r.OlderThan < 0 {
+		return errors.New("The age of the entries must be a positive number of days")
+	}
+
+	for _, pattern := range []string{r.TitlePattern, r.ContentPattern, r.AuthorPattern} {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("Invalid regular expression %q: %v", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Rule) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s", r.ID, r.UserID, r.Title)
+}
+
+// Rules represents a list of rules.
+type Rules []*Rule
@@ -0,0 +1,49 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateRule(t *testing.T) {
+	rule := Rule{Title: "Golang", TitlePattern: "(?i)golang", Star: true}
+	if err := rule.ValidateRule(); err != nil {
+		t.Errorf(`A valid rule should be accepted: %v`, err)
+	}
+}
+
+func TestValidateRuleWithoutTitle(t *testing.T) {
+	rule := Rule{TitlePattern: "golang", Star: true}
+	if err := rule.ValidateRule(); err == nil {
+		t.Error(`A rule without title should be rejected`)
+	}
+}
+
+func TestValidateRuleWithoutCondition(t *testing.T) {
+	rule := Rule{Title: "Everything", Star: true}
+	if err := rule.ValidateRule(); err == nil {
+		t.Error(`A rule without condition should be rejected`)
+	}
+}
+
+func TestValidateRuleWithoutAction(t *testing.T) {
+	rule := Rule{Title: "Golang", FeedID: 1}
+	if err := rule.ValidateRule(); err == nil {
+		t.Error(`A rule without action should be rejected`)
+	}
+}
+
+func TestValidateRuleWithInvalidPattern(t *testing.T) {
+	rule := Rule{Title: "Golang", AuthorPattern: "[a-z", MarkAsRead: true}
+	if err := rule.ValidateRule(); err == nil {
+		t.Error(`A rule with an invalid regular expression should be rejected`)
+	}
+}
+
+func TestValidateRuleWithNegativeAge(t *testing.T) {
+	rule := Rule{Title: "Old", OlderThan: -1, MarkAsRead: true}
+	if err := rule.ValidateRule(); err == nil {
+		t.Error(`A rule with a negative age should be rejected`)
+	}
+}
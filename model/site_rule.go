@@ -0,0 +1,88 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"miniflux.app/url"
+)
+
+// SiteRule represents the scraper and rewrite rules used by default for the entries of a website.
+// The global rules are shared by all users, the rules of a user override them.
+// A disabled rule means that no predefined rule is used for the website,
+// otherwise an empty field falls back to the predefined rules.
+type SiteRule struct {
+	ID           int64  `json:"id,omitempty"`
+	UserID       int64  `json:"user_id,omitempty"`
+	Global       bool   `json:"global"`
+	Domain       string `json:"domain"`
+	ScraperRules string `json:"scraper_rules"`
+	RewriteRules string `json:"rewrite_rules"`
+	Disabled     bool   `json:"disabled"`
+}
+
+// NormalizeDomain returns the domain in lower case, without spaces.
+func NormalizeDomain(domain string) string {
+	return strings.ToLower(strings.TrimSpace(domain))
+}
+
+// ValidateSiteRule validates site rule fields.
+func (r SiteRule) ValidateSiteRule() error {
+	if r.Domain == "" {
+		return errors.New("The domain is mandatory")
+	}
+
+	if strings.ContainsAny(r.Domain, " /:") {
+		return fmt.Errorf("Invalid domain %q, only the host name is expected", r.Domain)
+	}
+
+	return nil
+}
+
+func (r *SiteRule) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Domain=%s, Disabled=%v", r.ID, r.UserID, r.Domain, r.Disabled)
+}
+
+// SiteRules represents a list of site rules.
+type SiteRules []*SiteRule
+
+// Match returns the rule of the website, the rules of the user come before the global rules,
+// then the most specific domain is used. It returns nil when no rule is defined for the website.
+func (s SiteRules) Match(websiteURL string) *SiteRule {
+	urlDomain := strings.ToLower(url.Domain(websiteURL))
+
+	var match *SiteRule
+	for _, rule := range s {
+		if rule.Domain == "" || !strings.Contains(urlDomain, rule.Domain) {
+			continue
+		}
+
+		if match == nil ||
+			(match.Global && !rule.Global) ||
+			(match.Global == rule.Global && len(rule.Domain) > len(match.Domain)) {
+			match = rule
+		}
+	}
+
+	return match
+}
+
+// Export returns a copy of the rules without their identifiers, to be imported in another instance.
+func (s SiteRules) Export() SiteRules {
+	rules := make(SiteRules, 0, len(s))
+	for _, rule := range s {
+		rules = append(rules, &SiteRule{
+			Domain:       rule.Domain,
+			ScraperRules: rule.ScraperRules,
+			RewriteRules: rule.RewriteRules,
+			Disabled:     rule.Disabled,
+		})
+	}
+
+	return rules
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateSiteRule(t *testing.T) {
+	if err := (SiteRule{Domain: "example.org"}).ValidateSiteRule(); err != nil {
+		t.Errorf(`A rule with a domain should be valid: %v`, err)
+	}
+
+	for _, domain := range []string{"", "https://example.org", "example.org/path", "example org"} {
+		if err := (SiteRule{Domain: domain}).ValidateSiteRule(); err == nil {
+			t.Errorf(`The domain %q should be invalid`, domain)
+		}
+	}
+}
+
+func TestMatchSiteRule(t *testing.T) {
+	rules := SiteRules{
+		{ID: 1, Global: true, Domain: "example.org"},
+		{ID: 2, Global: true, Domain: "blog.example.org"},
+		{ID: 3, UserID: 1, Domain: "example.org"},
+		{ID: 4, Global: true, Domain: "example.com"},
+	}
+
+	scenarios := map[string]int64{
+		"https://www.example.org/article":  3,
+		"https://blog.example.org/article": 3,
+		"https://www.example.com/article":  4,
+		"https://WWW.EXAMPLE.COM/article":  4,
+		"https://example.net/article":      0,
+	}
+
+	for websiteURL, expected := range scenarios {
+		var id int64
+		if rule := rules.Match(websiteURL); rule != nil {
+			id = rule.ID
+		}
+
+		if id != expected {
+			t.Errorf(`Unexpected rule for %q, got #%d instead of #%d`, websiteURL, id, expected)
+		}
+	}
+
+	if rule := rules[:2].Match("https://blog.example.org/article"); rule == nil || rule.ID != 2 {
+		t.Errorf(`The most specific domain should be used, got %v`, rule)
+	}
+}
+
+func TestExportSiteRules(t *testing.T) {
+	rules := SiteRules{{ID: 1, UserID: 2, Domain: "example.org", ScraperRules: "article", Disabled: true}}
+	exported := rules.Export()
+
+	if len(exported) != 1 || exported[0].ID != 0 || exported[0].UserID != 0 {
+		t.Fatalf(`The identifiers should not be exported`)
+	}
+
+	if exported[0].Domain != "example.org" || exported[0].ScraperRules != "article" || !exported[0].Disabled {
+		t.Errorf(`Unexpected exported rule: %v`, exported[0])
+	}
+}
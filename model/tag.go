@@ -0,0 +1,61 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "strings"
+
+// Tag represents a label attached to entries, given by the feed or added by the user.
+type Tag struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	Title      string `json:"title"`
+	EntryCount int    `json:"entry_count,omitempty"`
+}
+
+func (t *Tag) String() string {
+	return t.Title
+}
+
+// Tags represents a list of tags.
+type Tags []*Tag
+
+// Titles returns the title of each tag.
+func (t Tags) Titles() []string {
+	titles := make([]string, 0, len(t))
+	for _, tag := range t {
+		titles = append(titles, tag.Title)
+	}
+	return titles
+}
+
+// ParseTags splits a comma separated list of tags, blank and repeated values are ignored.
+func ParseTags(text string) []string {
+	return NormalizeTags(strings.Split(text, ","))
+}
+
+// NormalizeTags trims the given tags, blank and repeated values are ignored.
+func NormalizeTags(titles []string) []string {
+	var normalized []string
+	for _, title := range titles {
+		normalized = appendTitle(normalized, title)
+	}
+	return normalized
+}
+
+// appendTitle adds a title to the list unless it is blank or already present with a different case.
+func appendTitle(titles []string, title string) []string {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return titles
+	}
+
+	for _, existing := range titles {
+		if strings.EqualFold(existing, title) {
+			return titles
+		}
+	}
+
+	return append(titles, title)
+}
@@ -0,0 +1,33 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTags(t *testing.T) {
+	scenarios := map[string][]string{
+		"":                       nil,
+		" , ,":                   nil,
+		"go":                     {"go"},
+		"go, Reading list ,rust": {"go", "Reading list", "rust"},
+		"Go,go,GO":               {"Go"},
+	}
+
+	for input, expected := range scenarios {
+		if actual := ParseTags(input); !reflect.DeepEqual(actual, expected) {
+			t.Errorf(`Unexpected tags for %q, got %v instead of %v`, input, actual, expected)
+		}
+	}
+}
+
+func TestTagsTitles(t *testing.T) {
+	tags := Tags{{ID: 1, Title: "go"}, {ID: 2, Title: "rust"}}
+	if titles := tags.Titles(); !reflect.DeepEqual(titles, []string{"go", "rust"}) {
+		t.Errorf(`Unexpected titles, got %v`, titles)
+	}
+}
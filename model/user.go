@@ -6,24 +6,35 @@ package model // import "miniflux.app/model"
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"miniflux.app/timezone"
 )
 
+// Policies applied to entries already published by another feed of the same user.
+const (
+	DuplicatePolicyNone  = "none"
+	DuplicatePolicyRead  = "read"
+	DuplicatePolicyGroup = "group"
+)
+
 // User represents a user in the system.
 type User struct {
-	ID                int64             `json:"id"`
-	Username          string            `json:"username"`
-	Password          string            `json:"password,omitempty"`
-	IsAdmin           bool              `json:"is_admin"`
-	Theme             string            `json:"theme"`
-	Language          string            `json:"language"`
-	Timezone          string            `json:"timezone"`
-	EntryDirection    string            `json:"entry_sorting_direction"`
-	KeyboardShortcuts bool              `json:"keyboard_shortcuts"`
-	LastLoginAt       *time.Time        `json:"last_login_at,omitempty"`
-	Extra             map[string]string `json:"extra"`
+	ID                        int64             `json:"id"`
+	Username                  string            `json:"username"`
+	Password                  string            `json:"password,omitempty"`
+	IsAdmin                   bool              `json:"is_admin"`
+	Theme                     string            `json:"theme"`
+	Language                  string            `json:"language"`
+	Timezone                  string            `json:"timezone"`
+	EntryDirection            string            `json:"entry_sorting_direction"`
+	KeyboardShortcuts         bool              `json:"keyboard_shortcuts"`
+	DuplicatePolicy           string            `json:"duplicate_policy"`
+	DuplicateMatchTitle       bool              `json:"duplicate_match_title"`
+	MarkReadOnMediaCompletion bool              `json:"mark_read_on_media_completion"`
+	LastLoginAt               *time.Time        `json:"last_login_at,omitempty"`
+	Extra                     map[string]string `json:"extra"`
 }
 
 // NewUser returns a new User.
@@ -42,6 +53,12 @@ func (u User) ValidateUserCreation() error {
 
 // ValidateUserModification validates user modification payload.
 func (u User) ValidateUserModification() error {
+	if u.DuplicatePolicy != "" {
+		if err := ValidateDuplicatePolicy(u.DuplicatePolicy); err != nil {
+			return err
+		}
+	}
+
 	if u.Theme != "" {
 		return ValidateTheme(u.Theme)
 	}
@@ -75,6 +92,16 @@ func (u User) ValidatePassword() error {
 	return nil
 }
 
+// ValidateDuplicatePolicy makes sure the duplicate policy is valid.
+func ValidateDuplicatePolicy(policy string) error {
+	switch policy {
+	case DuplicatePolicyNone, DuplicatePolicyRead, DuplicatePolicyGroup:
+		return nil
+	}
+
+	return fmt.Errorf(`Invalid duplicate policy, valid values are: "%s", "%s" and "%s"`, DuplicatePolicyNone, DuplicatePolicyRead, DuplicatePolicyGroup)
+}
+
 // UseTimezone converts last login date to the given timezone.
 func (u *User) UseTimezone(tz string) {
 	if u.LastLoginAt != nil {
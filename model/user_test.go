@@ -58,4 +58,14 @@ func TestValidateUserModification(t *testing.T) {
 	if err := user.ValidateUserModification(); err == nil {
 		t.Error(`An invalid password should generate an error`)
 	}
+
+	user = &User{DuplicatePolicy: DuplicatePolicyGroup}
+	if err := user.ValidateUserModification(); err != nil {
+		t.Error(`A valid duplicate policy should not generate any errors`)
+	}
+
+	user = &User{DuplicatePolicy: "invalid"}
+	if err := user.ValidateUserModification(); err == nil {
+		t.Error(`An invalid duplicate policy should generate an error`)
+	}
 }
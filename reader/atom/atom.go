@@ -15,6 +15,7 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/date"
+	"miniflux.app/reader/media"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/url"
 )
@@ -29,6 +30,8 @@ type atomFeed struct {
 }
 
 type atomEntry struct {
+	// The Media RSS elements come first to not be confused with the elements of the entry with the same name, like the content.
+	media.Element
 	ID         string         `xml:"id"`
 	Title      atomContent    `xml:"title"`
 	Published  string         `xml:"published"`
@@ -36,8 +39,13 @@ type atomEntry struct {
 	Links      []atomLink     `xml:"link"`
 	Summary    atomContent    `xml:"summary"`
 	Content    atomContent    `xml:"content"`
-	MediaGroup atomMediaGroup `xml:"http://search.yahoo.com/mrss/ group"`
 	Author     atomAuthor     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
 }
 
 type atomAuthor struct {
@@ -58,14 +66,12 @@ type atomContent struct {
 	XML  string `xml:",innerxml"`
 }
 
-type atomMediaGroup struct {
-	Description string `xml:"http://search.yahoo.com/mrss/ description"`
-}
-
 func (a *atomFeed) Transform() *model.Feed {
 	feed := new(model.Feed)
 	feed.FeedURL = getRelationURL(a.Links, "self")
 	feed.SiteURL = getURL(a.Links)
+	feed.HubURL = getRelationURL(a.Links, "hub")
+	feed.HubTopicURL = feed.FeedURL
 	feed.Title = strings.TrimSpace(a.Title)
 
 	if feed.Title == "" {
@@ -99,9 +105,17 @@ func (a *atomEntry) Transform() *model.Entry {
 	entry.Date = getDate(a)
 	entry.Author = getAuthor(a.Author)
 	entry.Hash = getHash(a)
-	entry.Content = getContent(a)
+	entry.Content = a.EmbedPlayer(getContent(a))
 	entry.Title = getTitle(a)
 	entry.Enclosures = getEnclosures(a)
+	entry.ThumbnailURL = a.ThumbnailURL()
+	for _, category := range a.Categories {
+		if category.Label != "" {
+			entry.AddCategories(category.Label)
+		} else {
+			entry.AddCategories(category.Term)
+		}
+	}
 	return entry
 }
 
@@ -179,11 +193,7 @@ func getContent(a *atomEntry) string {
 		return r
 	}
 
-	if a.MediaGroup.Description != "" {
-		return a.MediaGroup.Description
-	}
-
-	return ""
+	return a.Description()
 }
 
 func getTitle(a *atomEntry) string {
@@ -211,6 +221,12 @@ func getEnclosures(a *atomEntry) model.EnclosureList {
 		}
 	}
 
+	for _, enclosure := range a.Element.Enclosures() {
+		if !enclosures.Contains(enclosure.URL) {
+			enclosures = append(enclosures, enclosure)
+		}
+	}
+
 	return enclosures
 }
 
@@ -150,6 +150,30 @@ func TestParseFeedURL(t *testing.T) {
 	}
 }
 
+func TestParseFeedWithWebSubHub(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+	  <title>Example Feed</title>
+	  <link rel="alternate" type="text/html" href="https://example.org/"/>
+	  <link rel="self" type="application/atom+xml" href="https://example.org/feed"/>
+	  <link rel="hub" href="https://hub.example.org/"/>
+	  <updated>2003-12-13T18:30:02Z</updated>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Error(err)
+	}
+
+	if feed.HubURL != "https://hub.example.org/" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+
+	if feed.HubTopicURL != "https://example.org/feed" {
+		t.Errorf("Incorrect topic URL, got: %s", feed.HubTopicURL)
+	}
+}
+
 func TestParseEntryWithRelativeURL(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 	<feed xmlns="http://www.w3.org/2005/Atom">
@@ -418,6 +442,47 @@ func TestParseEntryWithoutAuthorName(t *testing.T) {
 	}
 }
 
+func TestParseYouTubeEntryWithMediaGroup(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<feed xmlns:yt="http://www.youtube.com/xml/schemas/2015" xmlns:media="http://search.yahoo.com/mrss/" xmlns="http://www.w3.org/2005/Atom">
+		<link rel="self" href="https://www.youtube.com/feeds/videos.xml?channel_id=123"/>
+		<id>yt:channel:123</id>
+		<title>Channel</title>
+		<link rel="alternate" href="https://www.youtube.com/channel/123"/>
+		<entry>
+			<id>yt:video:abc</id>
+			<title>Video</title>
+			<link rel="alternate" href="https://www.youtube.com/watch?v=abc"/>
+			<published>2019-01-26T08:02:28+00:00</published>
+			<media:group>
+				<media:title>Video</media:title>
+				<media:content url="https://www.youtube.com/v/abc?version=3" type="application/x-shockwave-flash" width="640" height="390"/>
+				<media:thumbnail url="https://i4.ytimg.com/vi/abc/hqdefault.jpg" width="480" height="360"/>
+				<media:description>First line
+Second line &lt;3</media:description>
+			</media:group>
+		</entry>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := feed.Entries[0]
+	if entry.Content != "First line<br>Second line &lt;3" {
+		t.Errorf("Incorrect entry content, got: %q", entry.Content)
+	}
+
+	if entry.ThumbnailURL != "https://i4.ytimg.com/vi/abc/hqdefault.jpg" {
+		t.Errorf("Incorrect entry thumbnail, got: %s", entry.ThumbnailURL)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].URL != "https://i4.ytimg.com/vi/abc/hqdefault.jpg" {
+		t.Errorf("Only the thumbnail should be an enclosure, got: %v", entry.Enclosures)
+	}
+}
+
 func TestParseEntryWithEnclosures(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 	<feed xmlns="http://www.w3.org/2005/Atom">
@@ -551,6 +616,40 @@ func TestParseEntryWithPublishedAndUpdated(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+	  <title>Example Feed</title>
+	  <link href="http://example.org/"/>
+
+	  <entry>
+		<link href="http://example.org/2003/12/13/atom03"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+		<category term="tech" label="Technology"/>
+		<category term="go"/>
+		<summary>Some text.</summary>
+	  </entry>
+
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Technology", "go"}
+	categories := feed.Entries[0].Categories
+	if len(categories) != len(expected) {
+		t.Fatalf("Incorrect entry categories, got: %v", categories)
+	}
+
+	for i := range expected {
+		if categories[i] != expected[i] {
+			t.Errorf("Incorrect entry category, got: %q instead of %q", categories[i], expected[i])
+		}
+	}
+}
+
 func TestParseInvalidXml(t *testing.T) {
 	data := `garbage`
 	_, err := Parse(bytes.NewBufferString(data))
@@ -15,10 +15,14 @@ var (
 	errEncoding         = "Unable to normalize encoding: %q"
 	errEmptyFeed        = "This feed is empty"
 	errResourceNotFound = "Resource not found (404), this feed doesn't exists anymore, check the feed URL"
+	errResourceGone     = "This feed has been removed permanently (410), it will not be refreshed anymore"
 	errNotAuthorized    = "You are not authorized to access this resource (invalid username/password)"
 )
 
 // Exec executes a HTTP request and handles errors.
+//
+// The response is also returned along with the error when the server replied with
+// an error status code, so the caller can inspect headers like Retry-After.
 func Exec(request *client.Client) (*client.Response, *errors.LocalizedError) {
 	response, err := request.Get()
 	if err != nil {
@@ -28,16 +32,20 @@ func Exec(request *client.Client) (*client.Response, *errors.LocalizedError) {
 		return nil, errors.NewLocalizedError(errRequestFailed, err)
 	}
 
+	if response.IsGone() {
+		return response, errors.NewLocalizedError(errResourceGone)
+	}
+
 	if response.IsNotFound() {
-		return nil, errors.NewLocalizedError(errResourceNotFound)
+		return response, errors.NewLocalizedError(errResourceNotFound)
 	}
 
 	if response.IsNotAuthorized() {
-		return nil, errors.NewLocalizedError(errNotAuthorized)
+		return response, errors.NewLocalizedError(errNotAuthorized)
 	}
 
 	if response.HasServerFailure() {
-		return nil, errors.NewLocalizedError(errServerFailure, response.StatusCode)
+		return response, errors.NewLocalizedError(errServerFailure, response.StatusCode)
 	}
 
 	if response.StatusCode != 304 {
@@ -6,8 +6,11 @@ package feed // import "miniflux.app/reader/feed"
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 
+	"miniflux.app/config"
+	"miniflux.app/crypto"
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
 	"miniflux.app/locale"
@@ -17,6 +20,8 @@ import (
 	"miniflux.app/reader/icon"
 	"miniflux.app/reader/parser"
 	"miniflux.app/reader/processor"
+	"miniflux.app/reader/rule"
+	"miniflux.app/reader/websub"
 	"miniflux.app/storage"
 	"miniflux.app/timer"
 )
@@ -33,7 +38,7 @@ type Handler struct {
 }
 
 // CreateFeed fetch, parse and store a new feed.
-func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool, userAgent, username, password string) (*model.Feed, error) {
+func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool, userAgent, username, password, proxyURL string, headers map[string]string, cookie string) (*model.Feed, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:CreateFeed] feedUrl=%s", url))
 
 	if !h.store.CategoryExists(userID, categoryID) {
@@ -43,13 +48,16 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 	request := client.New(url)
 	request.WithCredentials(username, password)
 	request.WithUserAgent(userAgent)
+	request.WithProxy(proxyURL)
+	request.WithHeaders(headers)
+	request.WithCookie(cookie)
 	response, requestErr := browser.Exec(request)
 	if requestErr != nil {
 		return nil, requestErr
 	}
 
-	if h.store.FeedURLExists(userID, response.EffectiveURL) {
-		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
+	if h.store.FeedURLExists(userID, response.PermanentURL()) {
+		return nil, errors.NewLocalizedError(errDuplicate, response.PermanentURL())
 	}
 
 	subscription, parseErr := parser.ParseFeed(response.String())
@@ -59,9 +67,11 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 
 	subscription.UserID = userID
 	subscription.WithCategoryID(categoryID)
-	subscription.WithBrowsingParameters(crawler, userAgent, username, password)
+	subscription.WithBrowsingParameters(crawler, userAgent, username, password, proxyURL)
+	subscription.WithRequestHeaders(headers, cookie)
 	subscription.WithClientResponse(response)
 	subscription.CheckedNow()
+	subscription.ScheduleNextCheck(countWeeklyEntries(subscription.Entries), response.CacheMaxAge())
 
 	processor.ProcessFeedEntries(h.store, subscription)
 
@@ -71,34 +81,151 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 
 	logger.Debug("[Handler:CreateFeed] Feed saved with ID: %d", subscription.ID)
 
-	checkFeedIcon(h.store, subscription.ID, subscription.SiteURL)
+	// The entries stored with the new subscription are processed by the rules like the ones of the next refreshes.
+	rule.ApplyRules(h.store, subscription, subscription.Entries)
+
+	checkFeedIcon(h.store, subscription.ID, subscription.SiteURL, subscription.ProxyURL)
+	h.subscribeToHub(subscription)
 	return subscription, nil
 }
 
 // RefreshFeed fetch and update a feed if necessary.
 func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:RefreshFeed] feedID=%d", feedID))
-	userLanguage := h.store.UserLanguage(userID)
-	printer := locale.NewPrinter(userLanguage)
 
+	subscription, err := h.loadSubscription(userID, feedID)
+	if err != nil {
+		return err
+	}
+
+	originalFeed := subscription.feed
+	request := client.New(originalFeed.FeedURL)
+	request.WithCredentials(originalFeed.Username, originalFeed.Password)
+	request.WithCacheHeaders(originalFeed.EtagHeader, originalFeed.LastModifiedHeader)
+	request.WithUserAgent(originalFeed.UserAgent)
+	request.WithProxy(originalFeed.ProxyURL)
+	request.WithHeaders(originalFeed.Headers)
+	request.WithCookie(originalFeed.Cookie)
+
+	start := time.Now()
+	response, requestErr := browser.Exec(request)
+	duration := time.Since(start)
+
+	return h.updateSubscription(subscription, response, requestErr, duration, &sharedParser{response: response})
+}
+
+// RefreshFeeds refreshes several subscriptions to the same feed with a single HTTP request.
+// All the subscriptions must share the same feed URL, credentials, user agent, proxy and custom headers.
+func (h *Handler) RefreshFeeds(jobs model.JobList) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:RefreshFeeds] feedURL=%s subscriptions=%d", jobs[0].FeedURL, len(jobs)))
+
+	var subscriptions []*subscription
+	for _, job := range jobs {
+		subscription, err := h.loadSubscription(job.UserID, job.FeedID)
+		if err != nil {
+			logger.Error("[Handler:RefreshFeeds] %v", err)
+			continue
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	firstFeed := subscriptions[0].feed
+	request := client.New(firstFeed.FeedURL)
+	request.WithCredentials(firstFeed.Username, firstFeed.Password)
+	request.WithUserAgent(firstFeed.UserAgent)
+	request.WithProxy(firstFeed.ProxyURL)
+	request.WithHeaders(firstFeed.Headers)
+	request.WithCookie(firstFeed.Cookie)
+
+	// Caching headers are sent only when all subscriptions are in the same state,
+	// otherwise a 304 response would be wrong for some of them.
+	sameCacheHeaders := true
+	for _, subscription := range subscriptions {
+		if subscription.feed.EtagHeader != firstFeed.EtagHeader || subscription.feed.LastModifiedHeader != firstFeed.LastModifiedHeader {
+			sameCacheHeaders = false
+			break
+		}
+	}
+
+	if sameCacheHeaders {
+		request.WithCacheHeaders(firstFeed.EtagHeader, firstFeed.LastModifiedHeader)
+	}
+
+	start := time.Now()
+	response, requestErr := browser.Exec(request)
+	duration := time.Since(start)
+	feedParser := &sharedParser{response: response}
+
+	for _, subscription := range subscriptions {
+		if err := h.updateSubscription(subscription, response, requestErr, duration, feedParser); err != nil {
+			logger.Error("[Handler:RefreshFeeds] feed #%d: %v", subscription.feed.ID, err)
+		}
+	}
+}
+
+type subscription struct {
+	feed        *model.Feed
+	weeklyCount int
+	printer     *locale.Printer
+}
+
+func (h *Handler) loadSubscription(userID, feedID int64) (*subscription, error) {
 	originalFeed, storeErr := h.store.FeedByID(userID, feedID)
 	if storeErr != nil {
-		return storeErr
+		return nil, storeErr
 	}
 
 	if originalFeed == nil {
-		return errors.NewLocalizedError(errNotFound, feedID)
+		return nil, errors.NewLocalizedError(errNotFound, feedID)
+	}
+
+	weeklyCount, storeErr := h.store.WeeklyFeedEntryCount(userID, feedID)
+	if storeErr != nil {
+		return nil, storeErr
 	}
 
 	originalFeed.CheckedNow()
+	originalFeed.ScheduleNextCheck(weeklyCount, 0)
+
+	return &subscription{
+		feed:        originalFeed,
+		weeklyCount: weeklyCount,
+		printer:     locale.NewPrinter(h.store.UserLanguage(userID)),
+	}, nil
+}
+
+func (h *Handler) updateSubscription(subscription *subscription, response *client.Response, requestErr *errors.LocalizedError, duration time.Duration, feedParser *sharedParser) (err error) {
+	originalFeed := subscription.feed
+	printer := subscription.printer
+	feedID := originalFeed.ID
+
+	fetch := model.NewFeedFetch(feedID, response, duration)
+	defer func() {
+		if err != nil {
+			fetch.Error = originalFeed.ParsingErrorMsg
+		}
+		h.logFetch(fetch)
+	}()
 
-	request := client.New(originalFeed.FeedURL)
-	request.WithCredentials(originalFeed.Username, originalFeed.Password)
-	request.WithCacheHeaders(originalFeed.EtagHeader, originalFeed.LastModifiedHeader)
-	request.WithUserAgent(originalFeed.UserAgent)
-	response, requestErr := browser.Exec(request)
 	if requestErr != nil {
+		var retryAfter time.Duration
+		if response != nil {
+			retryAfter = response.RetryDelay()
+		}
+
+		// There is no need to retry when the publisher removed the feed on purpose.
+		if response != nil && response.IsGone() {
+			logger.Info("[Handler:RefreshFeed] Feed #%d is gone, disabling it", feedID)
+			originalFeed.Disabled = true
+			h.UnsubscribeFromHub(originalFeed)
+		}
+
 		originalFeed.WithError(requestErr.Localize(printer))
+		originalFeed.ScheduleNextRetry(retryAfter)
 		h.store.UpdateFeedError(originalFeed)
 		return requestErr
 	}
@@ -106,50 +233,271 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	if response.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d has been modified", feedID)
 
-		updatedFeed, parseErr := parser.ParseFeed(response.String())
+		updatedFeed, parseErr := feedParser.parse()
 		if parseErr != nil {
 			originalFeed.WithError(parseErr.Localize(printer))
+			originalFeed.ScheduleNextRetry(0)
 			h.store.UpdateFeedError(originalFeed)
 			return parseErr
 		}
 
 		originalFeed.Entries = updatedFeed.Entries
+		originalFeed.TTL = updatedFeed.TTL
+
+		if updatedFeed.HubURL != originalFeed.HubURL {
+			// The lease given by the previous hub is meaningless for the new one.
+			originalFeed.HubLeaseExpiresAt = time.Time{}
+		}
+		originalFeed.HubURL = updatedFeed.HubURL
+		originalFeed.HubTopicURL = updatedFeed.HubTopicURL
 		processor.ProcessFeedEntries(h.store, originalFeed)
 
 		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
-		if storeErr := h.store.UpdateEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler); storeErr != nil {
+		createdEntries, updatedCount, storeErr := h.store.UpdateEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler)
+		fetch.CreatedEntries, fetch.UpdatedEntries = len(createdEntries), updatedCount
+		if storeErr != nil {
 			originalFeed.WithError(storeErr.Error())
+			originalFeed.ScheduleNextRetry(0)
 			h.store.UpdateFeedError(originalFeed)
 			return storeErr
 		}
 
+		rule.ApplyRules(h.store, originalFeed, createdEntries)
+
+		logFeedRedirects(originalFeed, response, fetch)
+
 		// We update caching headers only if the feed has been modified,
 		// because some websites don't return the same headers when replying with a 304.
 		originalFeed.WithClientResponse(response)
-		checkFeedIcon(h.store, originalFeed.ID, originalFeed.SiteURL)
+		h.moveFeed(originalFeed, updatedFeed.NewFeedURL, fetch)
+		checkFeedIcon(h.store, originalFeed.ID, originalFeed.SiteURL, originalFeed.ProxyURL)
 	} else {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d not modified", feedID)
+		fetch.NotModified = true
 	}
 
 	originalFeed.ResetErrorCounter()
+	originalFeed.ScheduleNextCheck(subscription.weeklyCount, response.CacheMaxAge())
 
 	if storeErr := h.store.UpdateFeed(originalFeed); storeErr != nil {
 		originalFeed.WithError(storeErr.Error())
+		originalFeed.ScheduleNextRetry(0)
 		h.store.UpdateFeedError(originalFeed)
 		return storeErr
 	}
 
+	h.subscribeToHub(originalFeed)
+	return nil
+}
+
+// PushFeed stores the content pushed by a WebSub hub.
+func (h *Handler) PushFeed(userID, feedID int64, data string) error {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:PushFeed] feedID=%d", feedID))
+
+	originalFeed, storeErr := h.store.FeedByID(userID, feedID)
+	if storeErr != nil {
+		return storeErr
+	}
+
+	if originalFeed == nil {
+		return errors.NewLocalizedError(errNotFound, feedID)
+	}
+
+	if originalFeed.Disabled {
+		logger.Debug("[Handler:PushFeed] Feed #%d is disabled, ignoring the notification", feedID)
+		return nil
+	}
+
+	pushedFeed, parseErr := parser.ParseFeed(data)
+	if parseErr != nil {
+		return parseErr
+	}
+
+	originalFeed.Entries = pushedFeed.Entries
+	processor.ProcessFeedEntries(h.store, originalFeed)
+
+	// The hub may send only the new entries, the other ones must be kept.
+	createdEntries, _, storeErr := h.store.AppendEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler)
+	if storeErr != nil {
+		return storeErr
+	}
+
+	rule.ApplyRules(h.store, originalFeed, createdEntries)
 	return nil
 }
 
+// logFetch adds the refresh to the history of the feed.
+func (h *Handler) logFetch(fetch *model.FeedFetch) {
+	if config.Opts.FeedFetchLogSize() <= 0 {
+		return
+	}
+
+	if err := h.store.CreateFeedFetch(fetch, config.Opts.FeedFetchLogSize()); err != nil {
+		logger.Error("[Handler:LogFetch] %v", err)
+	}
+}
+
+func (h *Handler) subscribeToHub(feed *model.Feed) {
+	if !config.Opts.HasWebSub() || !feed.NeedsWebSubSubscription() {
+		return
+	}
+
+	// The secret must be stored before subscribing, because the hub may verify the intent right away.
+	if feed.HubSecret == "" {
+		feed.HubSecret = crypto.GenerateRandomString(32)
+		if err := h.store.UpdateFeedHubSecret(feed); err != nil {
+			logger.Error("[Handler:SubscribeToHub] %v", err)
+			return
+		}
+	}
+
+	if err := h.store.UpdateFeedHubPendingMode(feed, "subscribe", time.Now().Add(websub.VerificationTimeout)); err != nil {
+		logger.Error("[Handler:SubscribeToHub] %v", err)
+		return
+	}
+
+	if err := websub.Subscribe(feed); err != nil {
+		logger.Error("[Handler:SubscribeToHub] feed #%d: %v", feed.ID, err)
+		return
+	}
+
+	logger.Info("[Handler:SubscribeToHub] Feed #%d subscribed to hub %q", feed.ID, feed.HubURL)
+}
+
+// UnsubscribeFromHub asks the hub to stop pushing the updates of a feed removed or disabled by the user.
+func (h *Handler) UnsubscribeFromHub(feed *model.Feed) {
+	if !config.Opts.HasWebSub() || !feed.HasWebSubLease() {
+		return
+	}
+
+	if err := h.store.UpdateFeedHubPendingMode(feed, "unsubscribe", time.Now().Add(websub.VerificationTimeout)); err != nil {
+		logger.Error("[Handler:UnsubscribeFromHub] %v", err)
+		return
+	}
+
+	if err := websub.Unsubscribe(feed); err != nil {
+		logger.Error("[Handler:UnsubscribeFromHub] feed #%d: %v", feed.ID, err)
+		return
+	}
+
+	logger.Info("[Handler:UnsubscribeFromHub] Feed #%d unsubscribed from hub %q", feed.ID, feed.HubURL)
+}
+
+// sharedParser parses the response body only once, and gives a copy of the entries to each subscription.
+type sharedParser struct {
+	response *client.Response
+	parsed   bool
+	feed     *model.Feed
+	err      *errors.LocalizedError
+}
+
+func (p *sharedParser) parse() (*model.Feed, *errors.LocalizedError) {
+	if !p.parsed {
+		p.feed, p.err = parser.ParseFeed(p.response.String())
+		p.parsed = true
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return &model.Feed{
+		TTL:         p.feed.TTL,
+		HubURL:      p.feed.HubURL,
+		HubTopicURL: p.feed.HubTopicURL,
+		Entries:     p.feed.Entries.Copy(),
+	}, nil
+}
+
 // NewFeedHandler returns a feed handler.
 func NewFeedHandler(store *storage.Storage) *Handler {
 	return &Handler{store}
 }
 
-func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL string) {
+func countWeeklyEntries(entries model.Entries) int {
+	lastWeek := time.Now().AddDate(0, 0, -7)
+	weeklyCount := 0
+	for _, entry := range entries {
+		if entry.Date.After(lastWeek) {
+			weeklyCount++
+		}
+	}
+	return weeklyCount
+}
+
+// moveFeed changes the feed URL when the publisher announced a new address, like the itunes:new-feed-url element of podcasts.
+// The feed is not moved when the new address is not a valid feed or when the user is already subscribed to it.
+func (h *Handler) moveFeed(feed *model.Feed, newFeedURL string, fetch *model.FeedFetch) {
+	if newFeedURL == "" || newFeedURL == feed.FeedURL {
+		return
+	}
+
+	if u, err := url.Parse(newFeedURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		logger.Info("[Handler:RefreshFeed] Feed #%d moved to an invalid URL: %q", feed.ID, newFeedURL)
+		return
+	}
+
+	// The new address is fetched with the settings of the feed.
+	request := client.New(newFeedURL)
+	request.WithCredentials(feed.Username, feed.Password)
+	request.WithUserAgent(feed.UserAgent)
+	request.WithProxy(feed.ProxyURL)
+	request.WithHeaders(feed.Headers)
+	request.WithCookie(feed.Cookie)
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		logger.Info("[Handler:RefreshFeed] Feed #%d moved to %q, but this URL is not reachable: %v", feed.ID, newFeedURL, requestErr)
+		return
+	}
+
+	if _, parseErr := parser.ParseFeed(response.String()); parseErr != nil {
+		logger.Info("[Handler:RefreshFeed] Feed #%d moved to %q, but this URL is not a valid feed: %v", feed.ID, newFeedURL, parseErr)
+		return
+	}
+
+	newFeedURL = response.PermanentURL()
+	if newFeedURL == feed.FeedURL {
+		return
+	}
+
+	if h.store.FeedURLExists(feed.UserID, newFeedURL) {
+		logger.Info("[Handler:RefreshFeed] Feed #%d moved to %q, but this feed already exists", feed.ID, newFeedURL)
+		return
+	}
+
+	logger.Info("[Handler:RefreshFeed] Feed #%d moved from %q to %q", feed.ID, feed.FeedURL, newFeedURL)
+
+	// The fetch history keeps track of the address change, from the address before any redirect.
+	if fetch.PreviousURL == "" {
+		fetch.PreviousURL = feed.FeedURL
+	}
+	fetch.NewURL = newFeedURL
+	feed.FeedURL = newFeedURL
+
+	// The caching headers of the previous address are meaningless for the new one.
+	feed.EtagHeader = ""
+	feed.LastModifiedHeader = ""
+}
+
+func logFeedRedirects(feed *model.Feed, response *client.Response, fetch *model.FeedFetch) {
+	if len(response.Redirects) == 0 {
+		return
+	}
+
+	if newURL := response.PermanentURL(); newURL != feed.FeedURL {
+		logger.Info("[Handler:RefreshFeed] Feed #%d permanently moved from %q to %q", feed.ID, feed.FeedURL, newURL)
+
+		// The fetch history keeps track of the address change.
+		fetch.PreviousURL = feed.FeedURL
+		fetch.NewURL = newURL
+	} else {
+		logger.Info("[Handler:RefreshFeed] Feed #%d temporarily redirected to %q, keeping %q", feed.ID, response.EffectiveURL, feed.FeedURL)
+	}
+}
+
+func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL, proxyURL string) {
 	if !store.HasIcon(feedID) {
-		icon, err := icon.FindIcon(websiteURL)
+		icon, err := icon.FindIcon(websiteURL, proxyURL)
 		if err != nil {
 			logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
 		} else if icon == nil {
@@ -0,0 +1,102 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package filter // import "miniflux.app/reader/filter"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+)
+
+// Entry fields that can be matched by a rule.
+const (
+	FieldTitle    = "title"
+	FieldContent  = "content"
+	FieldAuthor   = "author"
+	FieldURL      = "url"
+	FieldCategory = "category"
+)
+
+// Rule matches a regular expression against a field of the entries.
+type Rule struct {
+	Field   string
+	Pattern *regexp.Regexp
+}
+
+// Match returns true if the field of the entry matches the regular expression.
+func (r *Rule) Match(entry *model.Entry) bool {
+	switch r.Field {
+	case FieldContent:
+		return r.Pattern.MatchString(sanitizer.StripTags(entry.Content))
+	case FieldAuthor:
+		return r.Pattern.MatchString(entry.Author)
+	case FieldURL:
+		return r.Pattern.MatchString(entry.URL)
+	case FieldCategory:
+		for _, category := range entry.Categories {
+			if r.Pattern.MatchString(category) {
+				return true
+			}
+		}
+		return false
+	default:
+		return r.Pattern.MatchString(entry.Title)
+	}
+}
+
+// Rules represents a list of rules.
+type Rules []*Rule
+
+// Match returns true if at least one rule matches the entry.
+func (r Rules) Match(entry *model.Entry) bool {
+	for _, rule := range r {
+		if rule.Match(entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRules parses one rule per line, in the form "field:regexp".
+// Lines without a known field apply to the title, blank lines are ignored.
+func ParseRules(text string) (Rules, error) {
+	var rules Rules
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		field, expression := FieldTitle, line
+		if parts := strings.SplitN(line, ":", 2); len(parts) == 2 {
+			switch name := strings.ToLower(strings.TrimSpace(parts[0])); name {
+			case FieldTitle, FieldContent, FieldAuthor, FieldURL, FieldCategory:
+				field, expression = name, strings.TrimSpace(parts[1])
+			}
+		}
+
+		pattern, err := regexp.Compile(expression)
+		if err != nil {
+			return nil, fmt.Errorf("filter: invalid rule %q: %v", line, err)
+		}
+
+		rules = append(rules, &Rule{Field: field, Pattern: pattern})
+	}
+
+	return rules, nil
+}
+
+// IsBlocked returns true if the entry should be filtered out according to the keep and block rules of the feed.
+// When keep rules are defined, only the entries matching one of them are allowed.
+func IsBlocked(keepRules, blockRules Rules, entry *model.Entry) bool {
+	if len(keepRules) > 0 && !keepRules.Match(entry) {
+		return true
+	}
+
+	return blockRules.Match(entry)
+}
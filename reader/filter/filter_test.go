@@ -0,0 +1,98 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package filter // import "miniflux.app/reader/filter"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules("title:(?i)sponsored\n\n  Author : ^Bot$ \nurl:https?://ads\\.\nWeekly digest\nfoo:bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct{ field, pattern string }{
+		{FieldTitle, "(?i)sponsored"},
+		{FieldAuthor, "^Bot$"},
+		{FieldURL, `https?://ads\.`},
+		{FieldTitle, "Weekly digest"},
+		{FieldTitle, "foo:bar"},
+	}
+
+	if len(rules) != len(expected) {
+		t.Fatalf(`Unexpected number of rules, got %d instead of %d`, len(rules), len(expected))
+	}
+
+	for i, rule := range rules {
+		if rule.Field != expected[i].field || rule.Pattern.String() != expected[i].pattern {
+			t.Errorf(`Unexpected rule #%d, got %s:%s`, i, rule.Field, rule.Pattern)
+		}
+	}
+}
+
+func TestParseInvalidRules(t *testing.T) {
+	if _, err := ParseRules("content:(unclosed"); err == nil {
+		t.Error(`An invalid regular expression should generate an error`)
+	}
+}
+
+func TestRuleMatch(t *testing.T) {
+	entry := &model.Entry{
+		Title:      "Weekly news",
+		Content:    `<p>Hello <a href="https://example.org/sponsor">World</a></p>`,
+		Author:     "Alice",
+		URL:        "https://example.org/news/1",
+		Categories: []string{"Politics", "Economy"},
+	}
+
+	scenarios := map[string]bool{
+		"(?i)weekly":        true,
+		"title:Daily":       false,
+		"content:Hello":     true,
+		"content:sponsor":   false,
+		"author:^Alice$":    true,
+		"url:/news/":        true,
+		"category:^Economy": true,
+		"category:Sports":   false,
+	}
+
+	for input, expected := range scenarios {
+		rules, err := ParseRules(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual := rules.Match(entry); actual != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, actual, expected)
+		}
+	}
+}
+
+func TestIsBlocked(t *testing.T) {
+	keepRules, _ := ParseRules("category:Go")
+	blockRules, _ := ParseRules("(?i)sponsored")
+
+	scenarios := []struct {
+		entry    *model.Entry
+		expected bool
+	}{
+		{&model.Entry{Title: "Go 1.12", Categories: []string{"Go"}}, false},
+		{&model.Entry{Title: "Sponsored: Go hosting", Categories: []string{"Go"}}, true},
+		{&model.Entry{Title: "Rust 1.33", Categories: []string{"Rust"}}, true},
+	}
+
+	for _, scenario := range scenarios {
+		if actual := IsBlocked(keepRules, blockRules, scenario.entry); actual != scenario.expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, scenario.entry.Title, actual, scenario.expected)
+		}
+	}
+
+	if IsBlocked(nil, nil, &model.Entry{Title: "Anything"}) {
+		t.Error(`Entries should not be blocked without rules`)
+	}
+}
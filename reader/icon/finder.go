@@ -21,9 +21,10 @@ import (
 )
 
 // FindIcon try to find the website's icon.
-func FindIcon(websiteURL string) (*model.Icon, error) {
+func FindIcon(websiteURL, proxyURL string) (*model.Icon, error) {
 	rootURL := url.RootURL(websiteURL)
 	clt := client.New(rootURL)
+	clt.WithProxy(proxyURL)
 	response, err := clt.Get()
 	if err != nil {
 		return nil, fmt.Errorf("unable to download website index page: %v", err)
@@ -43,7 +44,7 @@ func FindIcon(websiteURL string) (*model.Icon, error) {
 	}
 
 	logger.Debug("[FindIcon] Fetching icon => %s", iconURL)
-	icon, err := downloadIcon(iconURL)
+	icon, err := downloadIcon(iconURL, proxyURL)
 	if err != nil {
 		return nil, err
 	}
@@ -86,8 +87,9 @@ func parseDocument(websiteURL string, data io.Reader) (string, error) {
 	return iconURL, nil
 }
 
-func downloadIcon(iconURL string) (*model.Icon, error) {
+func downloadIcon(iconURL, proxyURL string) (*model.Icon, error) {
 	clt := client.New(iconURL)
+	clt.WithProxy(proxyURL)
 	response, err := clt.Get()
 	if err != nil {
 		return nil, fmt.Errorf("unable to download iconURL: %v", err)
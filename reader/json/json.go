@@ -22,9 +22,15 @@ type jsonFeed struct {
 	SiteURL string     `json:"home_page_url"`
 	FeedURL string     `json:"feed_url"`
 	Author  jsonAuthor `json:"author"`
+	Hubs    []jsonHub  `json:"hubs"`
 	Items   []jsonItem `json:"items"`
 }
 
+type jsonHub struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
 type jsonAuthor struct {
 	Name string `json:"name"`
 	URL  string `json:"url"`
@@ -41,6 +47,7 @@ type jsonItem struct {
 	DateModified  string           `json:"date_modified"`
 	Author        jsonAuthor       `json:"author"`
 	Attachments   []jsonAttachment `json:"attachments"`
+	Tags          []string         `json:"tags"`
 }
 
 type jsonAttachment struct {
@@ -55,9 +62,22 @@ func (j *jsonFeed) GetAuthor() string {
 	return getAuthor(j.Author)
 }
 
+// HubURL returns the WebSub hub advertised by the feed.
+func (j *jsonFeed) HubURL() string {
+	for _, hub := range j.Hubs {
+		if strings.EqualFold(hub.Type, "WebSub") {
+			return strings.TrimSpace(hub.URL)
+		}
+	}
+
+	return ""
+}
+
 func (j *jsonFeed) Transform() *model.Feed {
 	feed := new(model.Feed)
 	feed.FeedURL = j.FeedURL
+	feed.HubURL = j.HubURL()
+	feed.HubTopicURL = j.FeedURL
 	feed.SiteURL = j.SiteURL
 	feed.Title = strings.TrimSpace(j.Title)
 
@@ -155,6 +175,7 @@ func (j *jsonItem) Transform() *model.Entry {
 	entry.Content = j.GetContent()
 	entry.Title = strings.TrimSpace(j.GetTitle())
 	entry.Enclosures = j.GetEnclosures()
+	entry.AddCategories(j.Tags...)
 	return entry
 }
 
@@ -85,6 +85,33 @@ func TestParseJsonFeed(t *testing.T) {
 	}
 }
 
+func TestParseFeedWithWebSubHub(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"hubs": [
+			{"type": "rssCloud", "url": "https://cloud.example.org/"},
+			{"type": "WebSub", "url": "https://hub.example.org/"}
+		],
+		"items": []
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.HubURL != "https://hub.example.org/" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+
+	if feed.HubTopicURL != "https://example.org/feed.json" {
+		t.Errorf("Incorrect topic URL, got: %s", feed.HubTopicURL)
+	}
+}
+
 func TestParsePodcast(t *testing.T) {
 	data := `{
 		"version": "https://jsonfeed.org/version/1",
@@ -369,6 +396,38 @@ func TestParseTruncateItemTitle(t *testing.T) {
 	}
 }
 
+func TestParseItemWithTags(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"items": [
+			{
+				"url": "https://example.org/item",
+				"tags": ["Technology", "Go", ""]
+			}
+		]
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Technology", "Go"}
+	categories := feed.Entries[0].Categories
+	if len(categories) != len(expected) {
+		t.Fatalf("Incorrect entry categories, got: %v", categories)
+	}
+
+	for i := range expected {
+		if categories[i] != expected[i] {
+			t.Errorf("Incorrect entry category, got: %q instead of %q", categories[i], expected[i])
+		}
+	}
+}
+
 func TestParseInvalidJSON(t *testing.T) {
 	data := `garbage`
 	_, err := Parse(bytes.NewBufferString(data))
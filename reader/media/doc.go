@@ -0,0 +1,12 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package media handles the Media RSS elements used by RSS and Atom feeds.
+
+Specification: https://www.rssboard.org/media-rss
+
+*/
+package media // import "miniflux.app/reader/media"
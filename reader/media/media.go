@@ -0,0 +1,178 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package media // import "miniflux.app/reader/media"
+
+import (
+	"html"
+	"strconv"
+	"strings"
+
+	"miniflux.app/model"
+)
+
+// Element represents the Media RSS elements of an item.
+// The same elements are allowed in a media:group and, except the groups, in a media:content.
+type Element struct {
+	MediaGroups       []Element     `xml:"http://search.yahoo.com/mrss/ group"`
+	MediaContents     []Content     `xml:"http://search.yahoo.com/mrss/ content"`
+	MediaThumbnails   []Thumbnail   `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+	MediaDescriptions []Description `xml:"http://search.yahoo.com/mrss/ description"`
+	MediaPlayers      []Player      `xml:"http://search.yahoo.com/mrss/ player"`
+}
+
+// Content represents a media object: an image, a video, an audio file, etc.
+type Content struct {
+	URL      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	FileSize string `xml:"fileSize,attr"`
+	Medium   string `xml:"medium,attr"`
+	Element
+}
+
+// Thumbnail represents an image summarizing the media object.
+type Thumbnail struct {
+	URL string `xml:"url,attr"`
+}
+
+// Description represents the description of the media object, in plain text or in HTML.
+type Description struct {
+	Type string `xml:"type,attr"`
+	Data string `xml:",chardata"`
+}
+
+// Player represents the web page of a player for the media object.
+type Player struct {
+	URL string `xml:"url,attr"`
+}
+
+// MimeType returns the type of the media object, the medium is used when the type is not defined.
+func (c *Content) MimeType() string {
+	if c.Type != "" {
+		return strings.TrimSpace(c.Type)
+	}
+
+	switch c.Medium {
+	case "image", "audio", "video":
+		return c.Medium + "/*"
+	}
+
+	return "application/octet-stream"
+}
+
+// HTML returns the description as HTML, the plain text descriptions are escaped.
+func (d *Description) HTML() string {
+	data := strings.TrimSpace(d.Data)
+	if d.Type == "html" {
+		return data
+	}
+
+	return strings.Replace(html.EscapeString(data), "\n", "<br>", -1)
+}
+
+// Contents returns the media objects of the item, including the ones of the groups.
+func (e *Element) Contents() []Content {
+	contents := e.MediaContents
+	for _, group := range e.MediaGroups {
+		contents = append(contents, group.Contents()...)
+	}
+	return contents
+}
+
+// ThumbnailURL returns the first thumbnail of the item, of its groups or of its media objects.
+func (e *Element) ThumbnailURL() string {
+	for _, thumbnail := range e.MediaThumbnails {
+		if url := strings.TrimSpace(thumbnail.URL); url != "" {
+			return url
+		}
+	}
+
+	for _, element := range e.children() {
+		if url := element.ThumbnailURL(); url != "" {
+			return url
+		}
+	}
+
+	return ""
+}
+
+// Description returns the first description of the item, of its groups or of its media objects, as HTML.
+func (e *Element) Description() string {
+	for _, description := range e.MediaDescriptions {
+		if content := description.HTML(); content != "" {
+			return content
+		}
+	}
+
+	for _, element := range e.children() {
+		if content := element.Description(); content != "" {
+			return content
+		}
+	}
+
+	return ""
+}
+
+// PlayerURL returns the first player of the item, of its groups or of its media objects.
+func (e *Element) PlayerURL() string {
+	for _, player := range e.MediaPlayers {
+		if url := strings.TrimSpace(player.URL); url != "" {
+			return url
+		}
+	}
+
+	for _, element := range e.children() {
+		if url := element.PlayerURL(); url != "" {
+			return url
+		}
+	}
+
+	return ""
+}
+
+// Enclosures returns the media objects of the item as enclosures.
+// The thumbnail is added when there is no image, and the Flash objects, like the videos of YouTube feeds, are ignored.
+func (e *Element) Enclosures() model.EnclosureList {
+	enclosures := make(model.EnclosureList, 0)
+	hasImage := false
+	for _, content := range e.Contents() {
+		url := strings.TrimSpace(content.URL)
+		mimeType := content.MimeType()
+		if url == "" || mimeType == "application/x-shockwave-flash" {
+			continue
+		}
+
+		size, _ := strconv.ParseInt(content.FileSize, 10, 0)
+		enclosures = append(enclosures, &model.Enclosure{URL: url, MimeType: mimeType, Size: size})
+		hasImage = hasImage || strings.HasPrefix(mimeType, "image/")
+	}
+
+	if thumbnailURL := e.ThumbnailURL(); thumbnailURL != "" && !hasImage {
+		enclosures = append(enclosures, &model.Enclosure{URL: thumbnailURL, MimeType: "image/*"})
+	}
+
+	return enclosures
+}
+
+// EmbedPlayer adds the player of the item at the beginning of the content, when the content does not embed it already.
+// Only the players of trusted websites are kept by the sanitizer.
+func (e *Element) EmbedPlayer(content string) string {
+	playerURL := e.PlayerURL()
+	if playerURL == "" || strings.Contains(content, playerURL) || strings.Contains(content, html.EscapeString(playerURL)) {
+		return content
+	}
+
+	return `<iframe src="` + html.EscapeString(playerURL) + `" allowfullscreen></iframe>` + content
+}
+
+func (e *Element) children() []*Element {
+	var elements []*Element
+	for i := range e.MediaGroups {
+		elements = append(elements, &e.MediaGroups[i])
+	}
+	for i := range e.MediaContents {
+		elements = append(elements, &e.MediaContents[i].Element)
+	}
+	return elements
+}
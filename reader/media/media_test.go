@@ -0,0 +1,51 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package media // import "miniflux.app/reader/media"
+
+import "testing"
+
+func TestContentMimeType(t *testing.T) {
+	scenarios := []struct {
+		content  Content
+		expected string
+	}{
+		{Content{Type: "video/mp4", Medium: "video"}, "video/mp4"},
+		{Content{Medium: "image"}, "image/*"},
+		{Content{Medium: "document"}, "application/octet-stream"},
+		{Content{}, "application/octet-stream"},
+	}
+
+	for _, scenario := range scenarios {
+		if result := scenario.content.MimeType(); result != scenario.expected {
+			t.Errorf(`Unexpected MIME type for %q, got %q instead of %q`, scenario.content.Medium, result, scenario.expected)
+		}
+	}
+}
+
+func TestDescriptionHTML(t *testing.T) {
+	plain := Description{Data: " 1 < 2\nThe end "}
+	if result := plain.HTML(); result != "1 &lt; 2<br>The end" {
+		t.Errorf(`Unexpected plain description, got %q`, result)
+	}
+
+	html := Description{Type: "html", Data: "<p>Text</p>"}
+	if result := html.HTML(); result != "<p>Text</p>" {
+		t.Errorf(`Unexpected HTML description, got %q`, result)
+	}
+}
+
+func TestEmbedPlayer(t *testing.T) {
+	element := Element{MediaPlayers: []Player{{URL: "https://player.vimeo.com/video/1?a=1&b=2"}}}
+
+	expected := `<iframe src="https://player.vimeo.com/video/1?a=1&amp;b=2" allowfullscreen></iframe><p>Text</p>`
+	if result := element.EmbedPlayer("<p>Text</p>"); result != expected {
+		t.Errorf(`Unexpected content, got %q`, result)
+	}
+
+	content := `<iframe src="https://player.vimeo.com/video/1?a=1&amp;b=2"></iframe>`
+	if result := element.EmbedPlayer(content); result != content {
+		t.Errorf(`The player should not be embedded twice, got %q`, result)
+	}
+}
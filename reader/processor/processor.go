@@ -5,49 +5,302 @@
 package processor
 
 import (
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/config"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/filter"
+	"miniflux.app/reader/readingtime"
 	"miniflux.app/reader/rewrite"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/reader/scraper"
+	"miniflux.app/reader/urlcleaner"
+	"miniflux.app/reader/urlresolver"
 	"miniflux.app/storage"
+	"miniflux.app/url"
 )
 
 // ProcessFeedEntries downloads original web page for entries and apply filters.
 func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
-	for _, entry := range feed.Entries {
-		if feed.Crawler {
-			if !store.EntryURLExists(feed.ID, entry.URL) {
-				content, err := scraper.Fetch(entry.URL, feed.ScraperRules, feed.UserAgent)
-				if err != nil {
-					logger.Error(`[Filter] Unable to crawl this entry: %q => %v`, entry.URL, err)
-				} else if content != "" {
-					// We replace the entry content only if the scraper doesn't return any error.
-					entry.Content = content
-				}
+	filterEntries(feed)
+	resolveEntryURLs(store, feed)
+	removeTrackingParameters(feed)
+	siteRules := getSiteRules(store, feed.UserID)
+
+	if feed.Crawler {
+		var entries model.Entries
+		for _, entry := range feed.Entries {
+			if store.EntryNeedsCrawling(feed.ID, entry.URL) {
+				entries = append(entries, entry)
 			}
 		}
 
-		entry.Content = rewrite.Rewriter(entry.URL, entry.Content, feed.RewriteRules)
+		timeout := time.Duration(config.Opts.CrawlerTimeout()) * time.Second
+		crawlEntries(feed, entries, siteRules, config.Opts.CrawlerConcurrency(), timeout)
+	}
+
+	for _, entry := range feed.Entries {
+		rewrite.RewriteEntry(entry, feed.RewriteRules, siteRules)
+		entry.Content = removeTrackingParametersFromContent(feed, entry.Content)
 
 		// The sanitizer should always run at the end of the process to make sure unsafe HTML is filtered.
 		entry.Content = sanitizer.Sanitize(entry.URL, entry.Content)
+		entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content)
 	}
 }
 
-// ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
-func ProcessEntryWebPage(entry *model.Entry) error {
-	content, err := scraper.Fetch(entry.URL, entry.Feed.ScraperRules, entry.Feed.UserAgent)
+// filterEntries applies the keep and block rules of the feed.
+// Blocked entries are removed from the feed or marked as read.
+func filterEntries(feed *model.Feed) {
+	if feed.KeepRules == "" && feed.BlockRules == "" {
+		return
+	}
+
+	keepRules, err := filter.ParseRules(feed.KeepRules)
+	if err != nil {
+		logger.Error(`[Filter] Feed #%d: %v`, feed.ID, err)
+		return
+	}
+
+	blockRules, err := filter.ParseRules(feed.BlockRules)
+	if err != nil {
+		logger.Error(`[Filter] Feed #%d: %v`, feed.ID, err)
+		return
+	}
+
+	entries := make(model.Entries, 0, len(feed.Entries))
+	for _, entry := range feed.Entries {
+		if !filter.IsBlocked(keepRules, blockRules, entry) {
+			entries = append(entries, entry)
+			continue
+		}
+
+		logger.Debug(`[Filter] Feed #%d: entry %q is blocked`, feed.ID, entry.URL)
+		if feed.FilterAction == model.FilterActionRead {
+			entry.Status = model.EntryStatusRead
+			entries = append(entries, entry)
+		}
+	}
+
+	feed.Entries = entries
+}
+
+// resolveEntryURLs replaces the entry URLs with their final destination when the feed uses a redirector.
+// The destinations are cached per user to avoid sending the same requests during the next refreshes,
+// they are not shared because each feed resolves its URLs through its own proxy, headers and cookie.
+func resolveEntryURLs(store *storage.Storage, feed *model.Feed) {
+	if !feed.ResolveFinalURL {
+		return
+	}
+
+	var urls []string
+	for _, entry := range feed.Entries {
+		if entry.URL != "" {
+			urls = append(urls, entry.URL)
+		}
+	}
+
+	resolvedURLs, err := store.ResolvedURLs(feed.UserID, urls)
+	if err != nil {
+		logger.Error(`[Processor] Feed #%d: %v`, feed.ID, err)
+		resolvedURLs = make(map[string]string)
+	}
+
+	var unresolvedURLs []string
+	for _, entryURL := range urls {
+		if _, found := resolvedURLs[entryURL]; !found {
+			unresolvedURLs = append(unresolvedURLs, entryURL)
+		}
+	}
+
+	for entryURL, finalURL := range resolveURLs(feed, unresolvedURLs, config.Opts.CrawlerConcurrency()) {
+		resolvedURLs[entryURL] = finalURL
+		if err := store.SaveResolvedURL(feed.UserID, entryURL, finalURL); err != nil {
+			logger.Error(`[Processor] Feed #%d: %v`, feed.ID, err)
+		}
+	}
+
+	for _, entry := range feed.Entries {
+		if finalURL, found := resolvedURLs[entry.URL]; found {
+			entry.URL = finalURL
+		}
+	}
+}
+
+// resolveURLs follows the redirections of the given URLs concurrently.
+// The URLs that cannot be resolved are missing from the result, they are tried again during the next refresh.
+func resolveURLs(feed *model.Feed, urls []string, concurrency int) map[string]string {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	resolvedURLs := make(map[string]string, len(urls))
+	semaphore := make(chan struct{}, concurrency)
+
+	for _, entryURL := range urls {
+		wg.Add(1)
+		go func(entryURL string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			headers, cookie := requestHeaders(feed, entryURL)
+			finalURL, err := urlresolver.Resolve(entryURL, feed.UserAgent, feed.ProxyURL, headers, cookie)
+			if err != nil {
+				logger.Error(`[Processor] Feed #%d: unable to resolve %q: %v`, feed.ID, entryURL, err)
+				return
+			}
+
+			logger.Debug(`[Processor] Feed #%d: %q resolved to %q`, feed.ID, entryURL, finalURL)
+			mutex.Lock()
+			resolvedURLs[entryURL] = finalURL
+			mutex.Unlock()
+		}(entryURL)
+	}
+
+	wg.Wait()
+	return resolvedURLs
+}
+
+// removeTrackingParameters removes the tracking parameters from the entry URLs, unless the feed keeps them.
+// It runs before the crawler to fetch and store the cleaned URLs.
+func removeTrackingParameters(feed *model.Feed) {
+	if feed.KeepTrackingParameters {
+		return
+	}
+
+	parameters := config.Opts.TrackingParameters()
+	for _, entry := range feed.Entries {
+		entry.URL = urlcleaner.RemoveTrackingParameters(entry.URL, parameters)
+		entry.CommentsURL = urlcleaner.RemoveTrackingParameters(entry.CommentsURL, parameters)
+	}
+}
+
+// removeTrackingParametersFromContent removes the tracking parameters from the links of the content, unless the feed keeps them.
+func removeTrackingParametersFromContent(feed *model.Feed, content string) string {
+	if feed.KeepTrackingParameters {
+		return content
+	}
+
+	return urlcleaner.RemoveTrackingParametersFromContent(content, config.Opts.TrackingParameters())
+}
+
+type crawlResult struct {
+	entry   *model.Entry
+	content string
+	err     error
+}
+
+// crawlEntries downloads the web pages of the entries concurrently, within the given time limit.
+// Entries not crawled in time keep the content of the feed and are flagged to be crawled again later.
+func crawlEntries(feed *model.Feed, entries model.Entries, siteRules model.SiteRules, concurrency int, timeout time.Duration) {
+	if len(entries) == 0 {
+		return
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// The channel is large enough to never block the goroutines still running after the timeout.
+	results := make(chan crawlResult, len(entries))
+	expired := make(chan struct{})
+	semaphore := make(chan struct{}, concurrency)
+
+	for _, entry := range entries {
+		go func(entry *model.Entry) {
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			select {
+			case <-expired:
+				return
+			default:
+			}
+
+			headers, cookie := requestHeaders(feed, entry.URL)
+			content, err := scraper.Fetch(entry.URL, feed.ScraperRules, feed.UserAgent, feed.ProxyURL, headers, cookie, siteRules)
+			results <- crawlResult{entry: entry, content: content, err: err}
+		}(entry)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	pending := make(map[*model.Entry]bool, len(entries))
+	for _, entry := range entries {
+		pending[entry] = true
+	}
+
+	for len(pending) > 0 {
+		select {
+		case result := <-results:
+			delete(pending, result.entry)
+			result.entry.CrawlPending = false
+
+			if result.err != nil {
+				logger.Error(`[Filter] Unable to crawl this entry: %q => %v`, result.entry.URL, result.err)
+			} else if result.content != "" {
+				// We replace the entry content only if the scraper doesn't return any error.
+				result.entry.Content = result.content
+			}
+		case <-timer.C:
+			close(expired)
+			logger.Info(`[Filter] Feed #%d: %d entries not crawled after %v, they will be crawled again during the next refresh`, feed.ID, len(pending), timeout)
+			for entry := range pending {
+				entry.CrawlPending = true
+			}
+			return
+		}
+	}
+}
+
+// ProcessEntryWebPage downloads the entry web page, apply rewrite rules and estimate the new reading time.
+func ProcessEntryWebPage(store *storage.Storage, feed *model.Feed, entry *model.Entry) error {
+	siteRules := getSiteRules(store, feed.UserID)
+	headers, cookie := requestHeaders(feed, entry.URL)
+	content, err := scraper.Fetch(entry.URL, feed.ScraperRules, feed.UserAgent, feed.ProxyURL, headers, cookie, siteRules)
 	if err != nil {
 		return err
 	}
 
-	content = rewrite.Rewriter(entry.URL, content, entry.Feed.RewriteRules)
+	// Only the content is rewritten, the title of the entry is kept.
+	page := &model.Entry{URL: entry.URL, Content: content}
+	rewrite.RewriteEntry(page, feed.RewriteRules, siteRules)
+	content = removeTrackingParametersFromContent(feed, page.Content)
 	content = sanitizer.Sanitize(entry.URL, content)
 
 	if content != "" {
 		entry.Content = content
+		entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content)
 	}
 
 	return nil
 }
+
+// getSiteRules returns the site rules of the user, the predefined rules are used when they are not available.
+func getSiteRules(store *storage.Storage, userID int64) model.SiteRules {
+	siteRules, err := store.SiteRules(userID)
+	if err != nil {
+		logger.Error(`[Processor] Unable to fetch the site rules of user #%d: %v`, userID, err)
+		return nil
+	}
+
+	return siteRules
+}
+
+// requestHeaders returns the custom headers and the cookie of the feed to send with the request.
+// They are only sent to the hosts of the feed and of the website, to avoid leaking credentials to other websites.
+func requestHeaders(feed *model.Feed, pageURL string) (map[string]string, string) {
+	host := strings.ToLower(url.Domain(pageURL))
+	if host == strings.ToLower(url.Domain(feed.FeedURL)) || host == strings.ToLower(url.Domain(feed.SiteURL)) {
+		return feed.Headers, feed.Cookie
+	}
+
+	return nil, ""
+}
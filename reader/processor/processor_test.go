@@ -0,0 +1,173 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+func TestRequestHeadersForFeedHosts(t *testing.T) {
+	feed := &model.Feed{
+		FeedURL: "https://feeds.example.org/feed.xml",
+		SiteURL: "https://www.example.org/",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+		Cookie:  "session=abc",
+	}
+
+	for _, pageURL := range []string{"https://feeds.example.org/article", "https://WWW.example.org/article"} {
+		headers, cookie := requestHeaders(feed, pageURL)
+		if headers["Authorization"] != "Bearer token" || cookie != "session=abc" {
+			t.Errorf(`The custom headers should be sent to %q`, pageURL)
+		}
+	}
+}
+
+func TestRequestHeadersForOtherHosts(t *testing.T) {
+	feed := &model.Feed{
+		FeedURL: "https://example.org/feed.xml",
+		SiteURL: "https://example.org/",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+		Cookie:  "session=abc",
+	}
+
+	headers, cookie := requestHeaders(feed, "https://other.example.com/article")
+	if headers != nil || cookie != "" {
+		t.Error(`The custom headers should not be sent to other websites`)
+	}
+}
+
+func TestCrawlEntriesWithinTimeLimit(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(500 * time.Millisecond)
+		}
+
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><body><article>Crawled content</article></body></html>`)
+	}))
+	defer server.Close()
+
+	feed := &model.Feed{ScraperRules: "article"}
+	fast := &model.Entry{URL: server.URL + "/fast", Content: "Feed content"}
+	slow := &model.Entry{URL: server.URL + "/slow", Content: "Feed content"}
+
+	crawlEntries(feed, model.Entries{fast, slow}, nil, 2, 200*time.Millisecond)
+
+	if fast.Content != "Crawled content" || fast.CrawlPending {
+		t.Errorf(`The fast entry should be crawled, got content=%q pending=%v`, fast.Content, fast.CrawlPending)
+	}
+
+	if slow.Content != "Feed content" || !slow.CrawlPending {
+		t.Errorf(`The slow entry should keep the feed content, got content=%q pending=%v`, slow.Content, slow.CrawlPending)
+	}
+}
+
+func TestFilterEntries(t *testing.T) {
+	scenarios := []struct {
+		action   string
+		expected int
+	}{
+		{model.FilterActionDrop, 1},
+		{model.FilterActionRead, 3},
+	}
+
+	for _, scenario := range scenarios {
+		feed := &model.Feed{
+			KeepRules:    "category:(?i)^go$",
+			BlockRules:   "(?i)sponsored",
+			FilterAction: scenario.action,
+			Entries: model.Entries{
+				{Title: "Go 1.12 is released", Categories: []string{"Go"}},
+				{Title: "Sponsored: Go hosting", Categories: []string{"Go"}},
+				{Title: "Rust 1.33 is released", Categories: []string{"Rust"}},
+			},
+		}
+
+		filterEntries(feed)
+
+		if len(feed.Entries) != scenario.expected {
+			t.Fatalf(`Unexpected number of entries with the action %q, got %d instead of %d`, scenario.action, len(feed.Entries), scenario.expected)
+		}
+
+		if feed.Entries[0].Status != "" {
+			t.Errorf(`The allowed entry should not be modified, got status %q`, feed.Entries[0].Status)
+		}
+
+		for _, entry := range feed.Entries[1:] {
+			if entry.Status != model.EntryStatusRead {
+				t.Errorf(`The blocked entry %q should be marked as read`, entry.Title)
+			}
+		}
+	}
+}
+
+func TestRemoveTrackingParameters(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	feed := &model.Feed{Entries: model.Entries{
+		&model.Entry{URL: "https://example.org/article?id=1&utm_source=rss", CommentsURL: "https://example.org/comments?fbclid=abc"},
+	}}
+	removeTrackingParameters(feed)
+
+	if feed.Entries[0].URL != "https://example.org/article?id=1" {
+		t.Errorf(`Unexpected entry URL, got %q`, feed.Entries[0].URL)
+	}
+
+	if feed.Entries[0].CommentsURL != "https://example.org/comments" {
+		t.Errorf(`Unexpected comments URL, got %q`, feed.Entries[0].CommentsURL)
+	}
+}
+
+func TestKeepTrackingParameters(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	entryURL := "https://example.org/article?utm_source=rss"
+	content := `<a href="https://example.org/?utm_medium=feed">Link</a>`
+	feed := &model.Feed{KeepTrackingParameters: true, Entries: model.Entries{&model.Entry{URL: entryURL}}}
+	removeTrackingParameters(feed)
+
+	if feed.Entries[0].URL != entryURL {
+		t.Errorf(`The entry URL should not be modified, got %q`, feed.Entries[0].URL)
+	}
+
+	if output := removeTrackingParametersFromContent(feed, content); output != content {
+		t.Errorf(`The content should not be modified, got %q`, output)
+	}
+}
+
+func TestResolveURLs(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/~r/feed/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/article/1", http.StatusFound)
+	})
+	mux.HandleFunc("/article/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "article")
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	feed := &model.Feed{ID: 1}
+	resolvedURLs := resolveURLs(feed, []string{server.URL + "/~r/feed/1", server.URL + "/missing"}, 2)
+
+	if len(resolvedURLs) != 1 {
+		t.Fatalf(`Unexpected number of resolved URLs, got %d instead of 1`, len(resolvedURLs))
+	}
+
+	if resolvedURLs[server.URL+"/~r/feed/1"] != server.URL+"/article/1" {
+		t.Errorf(`Unexpected final URL, got %q`, resolvedURLs[server.URL+"/~r/feed/1"])
+	}
+}
@@ -377,6 +377,40 @@ func TestParseItemWithoutDate(t *testing.T) {
 	}
 }
 
+func TestParseItemWithSubjects(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns="http://purl.org/rss/1.0/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+	  <channel>
+			<title>Example</title>
+			<link>http://example.org</link>
+	  </channel>
+
+	  <item>
+			<title>Title</title>
+			<link>http://example.org/test.html</link>
+			<dc:subject>Technology</dc:subject>
+			<dc:subject>Go</dc:subject>
+	  </item>
+	</rdf:RDF>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Technology", "Go"}
+	categories := feed.Entries[0].Categories
+	if len(categories) != len(expected) {
+		t.Fatalf("Incorrect entry categories, got: %v", categories)
+	}
+
+	for i := range expected {
+		if categories[i] != expected[i] {
+			t.Errorf("Incorrect entry category, got: %q instead of %q", categories[i], expected[i])
+		}
+	}
+}
+
 func TestParseInvalidXml(t *testing.T) {
 	data := `garbage`
 	_, err := Parse(bytes.NewBufferString(data))
@@ -52,11 +52,12 @@ func (r *rdfFeed) Transform() *model.Feed {
 }
 
 type rdfItem struct {
-	Title       string `xml:"title"`
-	Link        string `xml:"link"`
-	Description string `xml:"description"`
-	Creator     string `xml:"creator"`
-	Date        string `xml:"date"`
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Creator     string   `xml:"creator"`
+	Date        string   `xml:"date"`
+	Subjects    []string `xml:"subject"`
 }
 
 func (r *rdfItem) Transform() *model.Entry {
@@ -67,6 +68,7 @@ func (r *rdfItem) Transform() *model.Entry {
 	entry.Content = r.Description
 	entry.Hash = getHash(r)
 	entry.Date = getDate(r)
+	entry.AddCategories(r.Subjects...)
 	return entry
 }
 
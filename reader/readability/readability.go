@@ -76,6 +76,12 @@ func ExtractContent(page io.Reader) (string, error) {
 		return "", err
 	}
 
+	return ExtractContentFromDocument(document), nil
+}
+
+// ExtractContentFromDocument returns relevant content of a parsed document.
+// The document is modified, the unlikely candidates are removed.
+func ExtractContentFromDocument(document *goquery.Document) string {
 	document.Find("script,style,noscript").Each(func(i int, s *goquery.Selection) {
 		removeNodes(s)
 	})
@@ -89,8 +95,7 @@ func ExtractContent(page io.Reader) (string, error) {
 	topCandidate := getTopCandidate(document, candidates)
 	logger.Debug("[Readability] TopCandidate: %v", topCandidate)
 
-	output := getArticle(topCandidate, candidates)
-	return output, nil
+	return getArticle(topCandidate, candidates)
 }
 
 // Now that we have the top candidate, look through its siblings for content that might also be related.
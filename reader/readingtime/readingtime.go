@@ -0,0 +1,75 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readingtime // import "miniflux.app/reader/readingtime"
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"unicode"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	// Average number of words read per minute.
+	wordsPerMinute = 265
+
+	// Chinese, Japanese and Korean texts are read character by character.
+	cjkCharactersPerMinute = 500
+)
+
+// EstimateReadingTime returns the estimated reading time of the HTML content in minutes.
+func EstimateReadingTime(content string) int {
+	words, characters := countWords(extractText(content))
+	return int(math.Ceil(float64(words)/wordsPerMinute + float64(characters)/cjkCharactersPerMinute))
+}
+
+// countWords returns the number of words and the number of CJK characters of the text.
+func countWords(text string) (words, characters int) {
+	inWord := false
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			characters++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				words++
+				inWord = true
+			}
+		case unicode.IsSpace(r):
+			inWord = false
+		}
+	}
+
+	return words, characters
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// extractText returns the text of the HTML content, the text of each element is separated by a space.
+func extractText(content string) string {
+	tokenizer := html.NewTokenizer(bytes.NewBufferString(content))
+	var buffer bytes.Buffer
+
+	for {
+		if tokenizer.Next() == html.ErrorToken {
+			if tokenizer.Err() == io.EOF {
+				return buffer.String()
+			}
+
+			return ""
+		}
+
+		token := tokenizer.Token()
+		if token.Type == html.TextToken {
+			buffer.WriteString(token.Data)
+			buffer.WriteString(" ")
+		}
+	}
+}
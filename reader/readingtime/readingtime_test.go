@@ -0,0 +1,64 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readingtime // import "miniflux.app/reader/readingtime"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCountWords(t *testing.T) {
+	scenarios := []struct {
+		text       string
+		words      int
+		characters int
+	}{
+		{"", 0, 0},
+		{"  \n ", 0, 0},
+		{"Hello world", 2, 0},
+		{"It's 2019 — isn't it?", 4, 0},
+		{"這是一個測試", 0, 6},
+		{"これはテストです", 0, 8},
+		{"Miniflux 是一个阅读器", 1, 6},
+	}
+
+	for _, scenario := range scenarios {
+		words, characters := countWords(scenario.text)
+		if words != scenario.words || characters != scenario.characters {
+			t.Errorf(`Unexpected count for %q, got %d words and %d characters instead of %d and %d`,
+				scenario.text, words, characters, scenario.words, scenario.characters)
+		}
+	}
+}
+
+func TestExtractTextSeparatesElements(t *testing.T) {
+	words, _ := countWords(extractText("<p>first</p><p>second<br>third</p>"))
+	if words != 3 {
+		t.Errorf(`Unexpected number of words, got %d instead of 3`, words)
+	}
+}
+
+func TestEstimateReadingTime(t *testing.T) {
+	scenarios := []struct {
+		content  string
+		expected int
+	}{
+		{"", 0},
+		{"<img src=\"image.png\">", 0},
+		{"<p>A short text.</p>", 1},
+		{"<p>" + strings.Repeat("word ", 265) + "</p>", 1},
+		{"<p>" + strings.Repeat("word ", 266) + "</p>", 2},
+		{"<p>" + strings.Repeat("word ", 1300) + "</p>", 5},
+		{"<p>" + strings.Repeat("字", 500) + "</p>", 1},
+		{"<p>" + strings.Repeat("字", 2400) + "</p>", 5},
+	}
+
+	for _, scenario := range scenarios {
+		result := EstimateReadingTime(scenario.content)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected reading time for %.40q, got %d instead of %d`, scenario.content, result, scenario.expected)
+		}
+	}
+}
@@ -5,6 +5,7 @@
 package rewrite // import "miniflux.app/reader/rewrite"
 
 import (
+	"encoding/base64"
 	"fmt"
 	"regexp"
 	"strings"
@@ -114,6 +115,11 @@ func addYoutubeVideo(entryURL, entryContent string) string {
 	return entryContent
 }
 
+func addCastopodEpisode(entryURL, entryContent string) string {
+	player := `<iframe width="650" frameborder="0" src="` + entryURL + `/embed/light"></iframe>`
+	return player + "<br>" + entryContent
+}
+
 func addPDFLink(entryURL, entryContent string) string {
 	if strings.HasSuffix(entryURL, ".pdf") {
 		return fmt.Sprintf(`<a href="%s">PDF</a><br>%s`, entryURL, entryContent)
@@ -128,3 +134,52 @@ func replaceTextLinks(input string) string {
 func replaceLineFeeds(input string) string {
 	return strings.Replace(input, "\n", "<br>", -1)
 }
+
+func removeElements(entryContent, selector string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	matches := doc.Find(selector)
+	if matches.Length() == 0 {
+		return entryContent
+	}
+
+	matches.Remove()
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+// decodeBase64Content replaces the text of the elements by its decoded value, when the text is base64 encoded.
+func decodeBase64Content(entryContent, selector string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	changed := false
+
+	doc.Find(selector).Each(func(i int, s *goquery.Selection) {
+		text := strings.TrimSpace(s.Text())
+		if text == "" {
+			return
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(text)
+		if err != nil {
+			return
+		}
+
+		changed = true
+		s.SetHtml(string(decoded))
+	})
+
+	if changed {
+		output, _ := doc.Find("body").First().Html()
+		return output
+	}
+
+	return entryContent
+}
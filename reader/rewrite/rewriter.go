@@ -5,41 +5,111 @@
 package rewrite // import "miniflux.app/reader/rewrite"
 
 import (
+	"regexp"
 	"strings"
 
 	"miniflux.app/logger"
+	"miniflux.app/model"
 	"miniflux.app/url"
 )
 
 // Rewriter modify item contents with a set of rewriting rules.
 func Rewriter(entryURL, entryContent, customRewriteRules string) string {
-	rulesList := getPredefinedRewriteRules(entryURL)
+	entry := &model.Entry{URL: entryURL, Content: entryContent}
+	RewriteEntry(entry, customRewriteRules, nil)
+	return entry.Content
+}
+
+// RewriteEntry modify the title and the content of an entry with a set of rewriting rules.
+// Without custom rules, the site rules are used before the predefined rules.
+func RewriteEntry(entry *model.Entry, customRewriteRules string, siteRules model.SiteRules) {
+	rulesList := getPredefinedRewriteRules(entry.URL, siteRules)
 	if customRewriteRules != "" {
 		rulesList = customRewriteRules
 	}
 
-	rules := strings.Split(rulesList, ",")
-	rules = append(rules, "add_pdf_download_link")
+	rules := parseRules(rulesList)
+	rules = append(rules, rule{name: "add_pdf_download_link"})
 
-	logger.Debug(`[Rewrite] Applying rules %v for %q`, rules, entryURL)
+	logger.Debug(`[Rewrite] Applying rules %v for %q`, rules, entry.URL)
 
 	for _, rule := range rules {
-		switch strings.TrimSpace(rule) {
-		case "add_image_title":
-			entryContent = addImageTitle(entryURL, entryContent)
-		case "add_dynamic_image":
-			entryContent = addDynamicImage(entryURL, entryContent)
-		case "add_youtube_video":
-			entryContent = addYoutubeVideo(entryURL, entryContent)
-		case "add_pdf_download_link":
-			entryContent = addPDFLink(entryURL, entryContent)
+		applyRule(entry, rule)
+	}
+}
+
+func applyRule(entry *model.Entry, rule rule) {
+	switch rule.name {
+	case "add_image_title":
+		entry.Content = addImageTitle(entry.URL, entry.Content)
+	case "add_dynamic_image":
+		entry.Content = addDynamicImage(entry.URL, entry.Content)
+	case "add_youtube_video":
+		entry.Content = addYoutubeVideo(entry.URL, entry.Content)
+	case "add_castopod_episode":
+		entry.Content = addCastopodEpisode(entry.URL, entry.Content)
+	case "add_pdf_download_link":
+		entry.Content = addPDFLink(entry.URL, entry.Content)
+	case "convert_text_link":
+		entry.Content = replaceTextLinks(entry.Content)
+	case "nl2br":
+		entry.Content = replaceLineFeeds(entry.Content)
+	case "replace":
+		entry.Content = replaceRegex(entry, rule, entry.Content)
+	case "replace_title":
+		entry.Title = replaceRegex(entry, rule, entry.Title)
+	case "remove":
+		if len(rule.args) != 1 {
+			logger.Error(`[Rewrite] Rule %s for %q: a CSS selector is required`, rule, entry.URL)
+			return
 		}
+		entry.Content = removeElements(entry.Content, rule.args[0])
+	case "base64_decode":
+		selector := "body"
+		if len(rule.args) > 0 && rule.args[0] != "" {
+			selector = rule.args[0]
+		}
+		entry.Content = decodeBase64Content(entry.Content, selector)
 	}
+}
 
-	return entryContent
+// replaceRegex applies the replace rules, the first argument is the regular expression and the second one the replacement.
+func replaceRegex(entry *model.Entry, rule rule, input string) string {
+	if len(rule.args) != 2 {
+		logger.Error(`[Rewrite] Rule %s for %q: a regular expression and a replacement are required`, rule, entry.URL)
+		return input
+	}
+
+	re, err := regexp.Compile(rule.args[0])
+	if err != nil {
+		logger.Error(`[Rewrite] Rule %s for %q: %v`, rule, entry.URL, err)
+		return input
+	}
+
+	return re.ReplaceAllString(input, rule.args[1])
 }
 
-func getPredefinedRewriteRules(entryURL string) string {
+// PredefinedRules returns a copy of the rewrite rules built into the application, by domain.
+func PredefinedRules() map[string]string {
+	rules := make(map[string]string, len(predefinedRules))
+	for domain, rule := range predefinedRules {
+		rules[domain] = rule
+	}
+	return rules
+}
+
+func getPredefinedRewriteRules(entryURL string, siteRules model.SiteRules) string {
+	if siteRule := siteRules.Match(entryURL); siteRule != nil {
+		if siteRule.Disabled {
+			return ""
+		}
+
+		// A site rule without rewrite rules keeps the predefined ones.
+		if siteRule.RewriteRules != "" {
+			return siteRule.RewriteRules
+		}
+	}
+
 	urlDomain := url.Domain(entryURL)
 
 	for domain, rules := range predefinedRules {
@@ -4,7 +4,11 @@
 
 package rewrite // import "miniflux.app/reader/rewrite"
 
-import "testing"
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
 
 func TestReplaceTextLinks(t *testing.T) {
 	scenarios := map[string]string{
@@ -138,3 +142,100 @@ func TestRewriteWithUnknownLazyNoScriptImage(t *testing.T) {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
 	}
 }
+
+func TestRewriteWithReplaceRule(t *testing.T) {
+	output := Rewriter("https://example.org/article", `<p>Some text.</p><p>12 comments</p>`, `replace("<p>\d+ comments?</p>"|"")`)
+	expected := `<p>Some text.</p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteWithInvalidReplaceRule(t *testing.T) {
+	output := Rewriter("https://example.org/article", `<p>Some text.</p>`, `replace("(unclosed"|""), replace("text")`)
+	expected := `<p>Some text.</p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteWithRemoveRule(t *testing.T) {
+	output := Rewriter("https://example.org/article", `<p>Some text.</p><div class="share">Share</div><div class="ad">Ad</div>`, `remove(".share, .ad")`)
+	expected := `<p>Some text.</p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteWithBase64DecodeRule(t *testing.T) {
+	output := Rewriter("https://example.org/article", `PHA+U29tZSB0ZXh0LjwvcD4=`, `base64_decode`)
+	expected := `<p>Some text.</p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+
+	output = Rewriter("https://example.org/article", `<p>Intro</p><div class="payload">PHA+U29tZSB0ZXh0LjwvcD4=</div>`, `base64_decode(".payload")`)
+	expected = `<p>Intro</p><div class="payload"><p>Some text.</p></div>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteWithCastopodEpisode(t *testing.T) {
+	output := Rewriter("https://podcast.example.org/@show/episodes/first", `Episode description`, `add_castopod_episode`)
+	expected := `<iframe width="650" frameborder="0" src="https://podcast.example.org/@show/episodes/first/embed/light"></iframe><br>Episode description`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteEntryTitle(t *testing.T) {
+	entry := &model.Entry{URL: "https://example.org/article", Title: "[Sponsored] Some title", Content: "Some text."}
+	RewriteEntry(entry, `replace_title("^\[Sponsored\] "|""), nl2br`, nil)
+
+	if entry.Title != "Some title" {
+		t.Errorf(`Unexpected title, got %q`, entry.Title)
+	}
+
+	if entry.Content != "Some text." {
+		t.Errorf(`Unexpected content, got %q`, entry.Content)
+	}
+}
+
+func TestRewriteWithSiteRules(t *testing.T) {
+	siteRules := model.SiteRules{
+		{ID: 1, Global: true, Domain: "xkcd.com", Disabled: true},
+		{ID: 2, Global: true, Domain: "example.org", RewriteRules: "nl2br"},
+		{ID: 3, Global: true, Domain: "abstrusegoose.com", ScraperRules: "article"},
+	}
+
+	entry := &model.Entry{URL: "https://xkcd.com/1912/", Content: `<img src="comic.png" title="Title">`}
+	RewriteEntry(entry, "", siteRules)
+	if entry.Content != `<img src="comic.png" title="Title">` {
+		t.Errorf(`A disabled site rule should disable the predefined rule, got %q`, entry.Content)
+	}
+
+	entry = &model.Entry{URL: "https://example.org/article", Content: "Line 1\nLine 2"}
+	RewriteEntry(entry, "", siteRules)
+	if entry.Content != "Line 1<br>Line 2" {
+		t.Errorf(`The site rule should be used, got %q`, entry.Content)
+	}
+
+	entry = &model.Entry{URL: "https://abstrusegoose.com/12", Content: `<img src="comic.png" title="Title">`}
+	RewriteEntry(entry, "", siteRules)
+	if entry.Content != `<figure><img src="comic.png" alt=""/><figcaption><p>Title</p></figcaption></figure>` {
+		t.Errorf(`A site rule without rewrite rules should keep the predefined rule, got %q`, entry.Content)
+	}
+
+	entry = &model.Entry{URL: "https://example.org/article", Content: "Line 1\nLine 2"}
+	RewriteEntry(entry, "convert_text_link", siteRules)
+	if entry.Content != "Line 1\nLine 2" {
+		t.Errorf(`The custom rules of the feed should be used before the site rules, got %q`, entry.Content)
+	}
+}
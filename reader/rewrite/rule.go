@@ -0,0 +1,109 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rewrite // import "miniflux.app/reader/rewrite"
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rule is a rewrite rule with its arguments, for example: replace("regex"|"replacement").
+type rule struct {
+	name string
+	args []string
+}
+
+func (r rule) String() string {
+	if len(r.args) == 0 {
+		return r.name
+	}
+
+	quoted := make([]string, len(r.args))
+	for i, arg := range r.args {
+		quoted[i] = fmt.Sprintf("%q", arg)
+	}
+
+	return fmt.Sprintf("%s(%s)", r.name, strings.Join(quoted, "|"))
+}
+
+// parseRules parses a list of rules separated by commas.
+// The arguments are separated by "|" and can be quoted to contain commas, "|" or parenthesis.
+// Inside quotes, \" is a quote, other backslashes are kept as is for regular expressions.
+func parseRules(text string) []rule {
+	var rules []rule
+	var current rule
+	var name, arg strings.Builder
+	inArgs, inQuotes, hasArg := false, false, false
+
+	endArg := func() {
+		if hasArg || arg.Len() > 0 {
+			value := arg.String()
+			if !hasArg {
+				value = strings.TrimSpace(value)
+			}
+			current.args = append(current.args, value)
+		}
+		arg.Reset()
+		hasArg = false
+	}
+
+	endRule := func() {
+		current.name = strings.TrimSpace(name.String())
+		if current.name != "" {
+			rules = append(rules, current)
+		}
+		current = rule{}
+		name.Reset()
+	}
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case inQuotes:
+			if r == '\\' && i+1 < len(runes) && runes[i+1] == '"' {
+				arg.WriteRune('"')
+				i++
+			} else if r == '"' {
+				inQuotes = false
+			} else {
+				arg.WriteRune(r)
+			}
+		case inArgs:
+			switch r {
+			case '"':
+				// The text around the quotes is ignored.
+				arg.Reset()
+				inQuotes, hasArg = true, true
+			case '|':
+				endArg()
+			case ')':
+				endArg()
+				inArgs = false
+			default:
+				if !hasArg {
+					arg.WriteRune(r)
+				}
+			}
+		default:
+			switch r {
+			case '(':
+				inArgs = true
+			case ',':
+				endRule()
+			default:
+				name.WriteRune(r)
+			}
+		}
+	}
+
+	if inArgs {
+		endArg()
+	}
+	endRule()
+
+	return rules
+}
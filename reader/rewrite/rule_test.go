@@ -0,0 +1,44 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rewrite // import "miniflux.app/reader/rewrite"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRules(t *testing.T) {
+	scenarios := []struct {
+		text     string
+		expected []rule
+	}{
+		{"", nil},
+		{"add_image_title", []rule{{name: "add_image_title"}}},
+		{" add_image_title , add_dynamic_image,", []rule{{name: "add_image_title"}, {name: "add_dynamic_image"}}},
+		{`replace("\d+ comments?"|"")`, []rule{{name: "replace", args: []string{`\d+ comments?`, ""}}}},
+		{`replace_title("^\[Sponsored\] "|"") , remove(".ad, .share")`, []rule{
+			{name: "replace_title", args: []string{`^\[Sponsored\] `, ""}},
+			{name: "remove", args: []string{".ad, .share"}},
+		}},
+		{`replace("(a|b)"|"<q class=\"x\">$1</q>")`, []rule{{name: "replace", args: []string{"(a|b)", `<q class="x">$1</q>`}}}},
+		{`base64_decode( .payload ), base64_decode`, []rule{{name: "base64_decode", args: []string{".payload"}}, {name: "base64_decode"}}},
+		{`base64_decode()`, []rule{{name: "base64_decode"}}},
+		{`remove("unclosed`, []rule{{name: "remove", args: []string{"unclosed"}}}},
+	}
+
+	for _, scenario := range scenarios {
+		rules := parseRules(scenario.text)
+		if !reflect.DeepEqual(rules, scenario.expected) {
+			t.Errorf(`Unexpected rules for %q, got %v instead of %v`, scenario.text, rules, scenario.expected)
+		}
+	}
+}
+
+func TestRuleString(t *testing.T) {
+	r := rule{name: "replace", args: []string{"a", `"b"`}}
+	if r.String() != `replace("a"|"\"b\"")` {
+		t.Errorf(`Unexpected rule string, got %s`, r)
+	}
+}
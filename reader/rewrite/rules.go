@@ -5,8 +5,9 @@
 package rewrite // import "miniflux.app/reader/rewrite"
 
 // List of predefined rewrite rules (alphabetically sorted)
-// Available rules: "add_image_title", "add_youtube_video"
-// domain => rule name
+// Available rules: "add_image_title", "add_dynamic_image", "add_youtube_video", "add_castopod_episode",
+// "convert_text_link", "nl2br", "replace", "replace_title", "remove" and "base64_decode"
+// domain => rule names
 var predefinedRules = map[string]string{
 	"abstrusegoose.com":      "add_image_title",
 	"amazingsuperpowers.com": "add_image_title",
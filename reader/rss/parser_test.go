@@ -10,6 +10,40 @@ import (
 	"time"
 )
 
+func TestParseEntryWithCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<link>https://example.org/</link>
+			<item>
+				<title>Item 1</title>
+				<link>https://example.org/item1</link>
+				<category>Technology</category>
+				<category domain="https://example.org/tags"> Go </category>
+				<category>technology</category>
+				<category></category>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Technology", "Go"}
+	categories := feed.Entries[0].Categories
+	if len(categories) != len(expected) {
+		t.Fatalf("Incorrect entry categories, got: %v", categories)
+	}
+
+	for i := range expected {
+		if categories[i] != expected[i] {
+			t.Errorf("Incorrect entry category, got: %q instead of %q", categories[i], expected[i])
+		}
+	}
+}
+
 func TestParseRss2Sample(t *testing.T) {
 	data := `
 		<?xml version="1.0"?>
@@ -230,6 +264,35 @@ func TestParseFeedURLWithAtomLink(t *testing.T) {
 	}
 }
 
+func TestParseFeedWithWebSubHub(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss xmlns:atom="http://www.w3.org/2005/Atom" version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<atom:link href="https://hub.example.org/" rel="hub"></atom:link>
+			<atom:link href="https://example.org/rss" type="application/rss+xml" rel="self"></atom:link>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Error(err)
+	}
+
+	if feed.HubURL != "https://hub.example.org/" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+
+	if feed.HubTopicURL != "https://example.org/rss" {
+		t.Errorf("Incorrect topic URL, got: %s", feed.HubTopicURL)
+	}
+
+	if feed.FeedURL != "https://example.org/rss" {
+		t.Errorf("Incorrect feed URL, got: %s", feed.FeedURL)
+	}
+}
+
 func TestParseEntryWithAuthorAndInnerHTML(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss xmlns:atom="http://www.w3.org/2005/Atom" version="2.0">
@@ -511,6 +574,111 @@ func TestParseEntryWithEnclosures(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithMediaRSS(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+		<title>My Photos</title>
+		<link>https://example.org</link>
+		<item>
+			<title>Sunset</title>
+			<link>https://example.org/photos/1</link>
+			<description></description>
+			<media:content url="https://example.org/photos/1.jpg" type="image/jpeg" fileSize="1000" medium="image" />
+			<media:thumbnail url="https://example.org/photos/1_small.jpg" />
+			<media:description type="plain">A sunset &amp; the sea
+Taken yesterday</media:description>
+		</item>
+		<item>
+			<title>Podcast</title>
+			<link>https://example.org/episodes/2</link>
+			<enclosure url="https://example.org/episodes/2.mp3" length="5000" type="audio/mpeg" />
+			<media:group>
+				<media:content url="https://example.org/episodes/2.mp3" type="audio/mpeg" fileSize="5000" />
+				<media:content url="https://example.org/episodes/2.ogg" medium="audio" />
+				<media:thumbnail url="https://example.org/episodes/2.png" />
+			</media:group>
+		</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Content != "A sunset &amp; the sea<br>Taken yesterday" {
+		t.Errorf("Incorrect entry content, got: %q", entry.Content)
+	}
+
+	if entry.ThumbnailURL != "https://example.org/photos/1_small.jpg" {
+		t.Errorf("Incorrect entry thumbnail, got: %s", entry.ThumbnailURL)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].URL != "https://example.org/photos/1.jpg" || entry.Enclosures[0].Size != 1000 {
+		t.Errorf("Incorrect enclosures, got: %v", entry.Enclosures)
+	}
+
+	entry = feed.Entries[1]
+	if entry.ThumbnailURL != "https://example.org/episodes/2.png" {
+		t.Errorf("Incorrect entry thumbnail, got: %s", entry.ThumbnailURL)
+	}
+
+	if len(entry.Enclosures) != 3 {
+		t.Fatalf("Incorrect number of enclosures, got: %d", len(entry.Enclosures))
+	}
+
+	if entry.Enclosures[1].URL != "https://example.org/episodes/2.ogg" || entry.Enclosures[1].MimeType != "audio/*" {
+		t.Errorf("Incorrect enclosure, got: %s (%s)", entry.Enclosures[1].URL, entry.Enclosures[1].MimeType)
+	}
+
+	if entry.Enclosures[2].URL != "https://example.org/episodes/2.png" || entry.Enclosures[2].MimeType != "image/*" {
+		t.Errorf("Incorrect enclosure, got: %s (%s)", entry.Enclosures[2].URL, entry.Enclosures[2].MimeType)
+	}
+}
+
+func TestParseEntryWithMediaPlayer(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+		<title>My Videos</title>
+		<link>https://vimeo.com/channels/example</link>
+		<item>
+			<title>Video</title>
+			<link>https://vimeo.com/123</link>
+			<description>&lt;p&gt;My video&lt;/p&gt;</description>
+			<media:content>
+				<media:player url="https://player.vimeo.com/video/123" />
+				<media:thumbnail url="https://i.vimeocdn.com/video/123.jpg" />
+			</media:content>
+		</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<iframe src="https://player.vimeo.com/video/123" allowfullscreen></iframe><p>My video</p>`
+	if feed.Entries[0].Content != expected {
+		t.Errorf("Incorrect entry content, got: %q", feed.Entries[0].Content)
+	}
+
+	if feed.Entries[0].ThumbnailURL != "https://i.vimeocdn.com/video/123.jpg" {
+		t.Errorf("Incorrect entry thumbnail, got: %s", feed.Entries[0].ThumbnailURL)
+	}
+
+	if len(feed.Entries[0].Enclosures) != 1 || feed.Entries[0].Enclosures[0].MimeType != "image/*" {
+		t.Errorf("Only the thumbnail should be an enclosure, got: %v", feed.Entries[0].Enclosures)
+	}
+}
+
 func TestParseEntryWithFeedBurnerEnclosures(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss version="2.0" xmlns:feedburner="http://rssnamespace.org/feedburner/ext/1.0">
@@ -614,3 +782,150 @@ func TestParseInvalidXml(t *testing.T) {
 		t.Error("Parse should returns an error")
 	}
 }
+
+func TestParseFeedWithTTL(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+		<channel>
+			<link>https://example.org/</link>
+			<ttl>120</ttl>
+			<sy:updatePeriod>daily</sy:updatePeriod>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.TTL != 120 {
+		t.Errorf("Incorrect TTL, got: %d", feed.TTL)
+	}
+}
+
+func TestParseFeedWithSyndicationModule(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+		<channel>
+			<link>https://example.org/</link>
+			<sy:updatePeriod>hourly</sy:updatePeriod>
+			<sy:updateFrequency>2</sy:updateFrequency>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.TTL != 30 {
+		t.Errorf("Incorrect TTL, got: %d", feed.TTL)
+	}
+}
+
+func TestParseFeedWithoutTTL(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<link>https://example.org/</link>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.TTL != 0 {
+		t.Errorf("Incorrect TTL, got: %d", feed.TTL)
+	}
+}
+
+func TestParseItunesPodcast(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+		<channel>
+			<title>Podcast</title>
+			<link>https://example.org/</link>
+			<itunes:image href="https://example.org/artwork.jpg"/>
+			<itunes:new-feed-url>https://example.org/new-feed.xml</itunes:new-feed-url>
+			<itunes:category text="Technology">
+				<itunes:category text="Podcasting"/>
+			</itunes:category>
+			<item>
+				<title>Episode 1</title>
+				<link>https://example.org/episode-1</link>
+				<itunes:subtitle>The first episode</itunes:subtitle>
+				<itunes:summary>A summary</itunes:summary>
+				<itunes:duration>01:02:03</itunes:duration>
+				<itunes:episode>1</itunes:episode>
+				<itunes:season>2</itunes:season>
+				<itunes:explicit>yes</itunes:explicit>
+				<enclosure url="https://example.org/episode-1.mp3" length="100" type="audio/mpeg"/>
+			</item>
+			<item>
+				<title>Episode 2</title>
+				<link>https://example.org/episode-2</link>
+				<description>A description</description>
+				<category>News</category>
+				<itunes:image href="https://example.org/episode-2.jpg"/>
+				<itunes:explicit>clean</itunes:explicit>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.NewFeedURL != "https://example.org/new-feed.xml" {
+		t.Errorf("Incorrect new feed URL, got: %s", feed.NewFeedURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Subtitle != "The first episode" {
+		t.Errorf("Incorrect entry subtitle, got: %s", entry.Subtitle)
+	}
+
+	if entry.Content != "A summary" {
+		t.Errorf("Incorrect entry content, got: %s", entry.Content)
+	}
+
+	if entry.Episode != 1 || entry.Season != 2 || !entry.Explicit {
+		t.Errorf("Incorrect podcast metadata, got: episode=%d season=%d explicit=%v", entry.Episode, entry.Season, entry.Explicit)
+	}
+
+	if entry.ThumbnailURL != "https://example.org/artwork.jpg" {
+		t.Errorf("Incorrect entry thumbnail, got: %s", entry.ThumbnailURL)
+	}
+
+	if len(entry.Categories) != 2 || entry.Categories[0] != "Technology" || entry.Categories[1] != "Podcasting" {
+		t.Errorf("Incorrect entry categories, got: %v", entry.Categories)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].Duration != 3723 {
+		t.Errorf("Incorrect enclosure duration, got: %v", entry.Enclosures)
+	}
+
+	entry = feed.Entries[1]
+	if entry.Content != "A description" {
+		t.Errorf("Incorrect entry content, got: %s", entry.Content)
+	}
+
+	if entry.Explicit {
+		t.Errorf("The entry should not be explicit")
+	}
+
+	if entry.ThumbnailURL != "https://example.org/episode-2.jpg" {
+		t.Errorf("Incorrect entry thumbnail, got: %s", entry.ThumbnailURL)
+	}
+
+	if len(entry.Categories) != 1 || entry.Categories[0] != "News" {
+		t.Errorf("Incorrect entry categories, got: %v", entry.Categories)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rss // import "miniflux.app/reader/rss"
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Specification: https://help.apple.com/itc/podcasts_connect/#/itcb54353390
+type itunesImage struct {
+	Href string `xml:"href,attr"`
+}
+
+type itunesCategory struct {
+	Text          string           `xml:"text,attr"`
+	Subcategories []itunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category"`
+}
+
+// itunesCategories returns the names of the categories, followed by the names of their subcategories.
+func itunesCategories(categories []itunesCategory) []string {
+	var names []string
+	for _, category := range categories {
+		if text := strings.TrimSpace(category.Text); text != "" {
+			names = append(names, text)
+		}
+		names = append(names, itunesCategories(category.Subcategories)...)
+	}
+	return names
+}
+
+// parseItunesDuration returns the number of seconds of a duration like "3723", "62:03" or "01:02:03".
+func parseItunesDuration(value string) int {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	seconds := 0
+	for _, part := range strings.Split(value, ":") {
+		number, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || number < 0 {
+			return 0
+		}
+		seconds = seconds*60 + int(number)
+	}
+
+	return seconds
+}
+
+// parseItunesExplicit returns true when the episode is flagged as explicit, the values "no" and "clean" are not explicit.
+func parseItunesExplicit(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "yes", "true", "explicit":
+		return true
+	default:
+		return false
+	}
+}
+
+func parseItunesNumber(value string) int {
+	number, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || number < 0 {
+		return 0
+	}
+	return number
+}
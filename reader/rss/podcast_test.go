@@ -0,0 +1,42 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rss // import "miniflux.app/reader/rss"
+
+import "testing"
+
+func TestParseItunesDuration(t *testing.T) {
+	scenarios := map[string]int{
+		"":          0,
+		"3723":      3723,
+		"62:03":     3723,
+		"01:02:03":  3723,
+		"1:02:03.5": 3723,
+		"invalid":   0,
+		"-10":       0,
+	}
+
+	for input, expected := range scenarios {
+		if result := parseItunesDuration(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %d instead of %d`, input, result, expected)
+		}
+	}
+}
+
+func TestParseItunesExplicit(t *testing.T) {
+	scenarios := map[string]bool{
+		"yes":      true,
+		"True":     true,
+		"explicit": true,
+		"no":       false,
+		"clean":    false,
+		"":         false,
+	}
+
+	for input, expected := range scenarios {
+		if result := parseItunesExplicit(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, result, expected)
+		}
+	}
+}
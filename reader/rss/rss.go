@@ -15,20 +15,27 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/date"
+	"miniflux.app/reader/media"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/url"
 )
 
 type rssFeed struct {
-	XMLName      xml.Name  `xml:"rss"`
-	Version      string    `xml:"version,attr"`
-	Title        string    `xml:"channel>title"`
-	Links        []rssLink `xml:"channel>link"`
-	Language     string    `xml:"channel>language"`
-	Description  string    `xml:"channel>description"`
-	PubDate      string    `xml:"channel>pubDate"`
-	ItunesAuthor string    `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>author"`
-	Items        []rssItem `xml:"channel>item"`
+	XMLName          xml.Name         `xml:"rss"`
+	Version          string           `xml:"version,attr"`
+	Title            string           `xml:"channel>title"`
+	Links            []rssLink        `xml:"channel>link"`
+	Language         string           `xml:"channel>language"`
+	Description      string           `xml:"channel>description"`
+	PubDate          string           `xml:"channel>pubDate"`
+	TimeToLive       string           `xml:"channel>ttl"`
+	UpdatePeriod     string           `xml:"http://purl.org/rss/1.0/modules/syndication/ channel>updatePeriod"`
+	UpdateFrequency  string           `xml:"http://purl.org/rss/1.0/modules/syndication/ channel>updateFrequency"`
+	ItunesAuthor     string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>author"`
+	ItunesImage      itunesImage      `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>image"`
+	ItunesCategories []itunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>category"`
+	ItunesNewFeedURL string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>new-feed-url"`
+	Items            []rssItem        `xml:"channel>item"`
 }
 
 type rssLink struct {
@@ -57,6 +64,8 @@ type rssEnclosure struct {
 }
 
 type rssItem struct {
+	// The Media RSS elements come first to not be confused with the elements of the item with the same name, like the description.
+	media.Element
 	GUID              string           `xml:"guid"`
 	Title             string           `xml:"title"`
 	Links             []rssLink        `xml:"link"`
@@ -69,7 +78,15 @@ type rssItem struct {
 	Authors           []rssAuthor      `xml:"author"`
 	Creator           string           `xml:"http://purl.org/dc/elements/1.1/ creator"`
 	EnclosureLinks    []rssEnclosure   `xml:"enclosure"`
+	Categories        []string         `xml:"category"`
 	OrigEnclosureLink string           `xml:"http://rssnamespace.org/feedburner/ext/1.0 origEnclosureLink"`
+	ItunesDuration    string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
+	ItunesImage       itunesImage      `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd image"`
+	ItunesSummary     string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
+	ItunesSubtitle    string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd subtitle"`
+	ItunesEpisode     string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd episode"`
+	ItunesSeason      string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd season"`
+	ItunesExplicit    string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit"`
 }
 
 func (r *rssFeed) SiteURL() string {
@@ -84,7 +101,7 @@ func (r *rssFeed) SiteURL() string {
 
 func (r *rssFeed) FeedURL() string {
 	for _, element := range r.Links {
-		if element.XMLName.Space == "http://www.w3.org/2005/Atom" {
+		if element.XMLName.Space == "http://www.w3.org/2005/Atom" && strings.ToLower(element.Rel) != "hub" {
 			return strings.TrimSpace(element.Href)
 		}
 	}
@@ -92,10 +109,55 @@ func (r *rssFeed) FeedURL() string {
 	return ""
 }
 
+// HubURL returns the WebSub hub advertised by the feed.
+func (r *rssFeed) HubURL() string {
+	for _, element := range r.Links {
+		if element.XMLName.Space == "http://www.w3.org/2005/Atom" && strings.ToLower(element.Rel) == "hub" {
+			return strings.TrimSpace(element.Href)
+		}
+	}
+
+	return ""
+}
+
+// TTL returns the number of minutes the feed may be cached, using the syndication module as a fallback.
+func (r *rssFeed) TTL() int {
+	if ttl, err := strconv.Atoi(strings.TrimSpace(r.TimeToLive)); err == nil && ttl > 0 {
+		return ttl
+	}
+
+	var period int
+	switch strings.TrimSpace(r.UpdatePeriod) {
+	case "hourly":
+		period = 60
+	case "daily":
+		period = 24 * 60
+	case "weekly":
+		period = 7 * 24 * 60
+	case "monthly":
+		period = 30 * 24 * 60
+	case "yearly":
+		period = 365 * 24 * 60
+	default:
+		return 0
+	}
+
+	frequency, err := strconv.Atoi(strings.TrimSpace(r.UpdateFrequency))
+	if err != nil || frequency <= 0 {
+		frequency = 1
+	}
+
+	return period / frequency
+}
+
 func (r *rssFeed) Transform() *model.Feed {
 	feed := new(model.Feed)
 	feed.SiteURL = r.SiteURL()
 	feed.FeedURL = r.FeedURL()
+	feed.HubURL = r.HubURL()
+	feed.HubTopicURL = feed.FeedURL
+	feed.TTL = r.TTL()
+	feed.NewFeedURL = strings.TrimSpace(r.ItunesNewFeedURL)
 	feed.Title = strings.TrimSpace(r.Title)
 
 	if feed.Title == "" {
@@ -110,6 +172,15 @@ func (r *rssFeed) Transform() *model.Feed {
 		}
 		entry.Author = strings.TrimSpace(sanitizer.StripTags(entry.Author))
 
+		// The artwork and the categories of the podcast are used for the episodes without their own.
+		if entry.ThumbnailURL == "" {
+			entry.ThumbnailURL = strings.TrimSpace(r.ItunesImage.Href)
+		}
+
+		if len(entry.Categories) == 0 {
+			entry.AddCategories(itunesCategories(r.ItunesCategories)...)
+		}
+
 		if entry.URL == "" {
 			entry.URL = feed.SiteURL
 		} else {
@@ -177,7 +248,15 @@ func (r *rssItem) Content() string {
 		return r.EncodedContent
 	}
 
-	return r.Description
+	if strings.TrimSpace(r.Description) != "" {
+		return r.Description
+	}
+
+	if strings.TrimSpace(r.ItunesSummary) != "" {
+		return r.ItunesSummary
+	}
+
+	return r.Element.Description()
 }
 
 func (r *rssItem) URL() string {
@@ -200,6 +279,7 @@ func (r *rssItem) URL() string {
 
 func (r *rssItem) Enclosures() model.EnclosureList {
 	enclosures := make(model.EnclosureList, 0)
+	duration := parseItunesDuration(r.ItunesDuration)
 
 	for _, enclosure := range r.EnclosureLinks {
 		length, _ := strconv.ParseInt(enclosure.Length, 10, 0)
@@ -216,9 +296,17 @@ func (r *rssItem) Enclosures() model.EnclosureList {
 			URL:      enclosureURL,
 			MimeType: enclosure.Type,
 			Size:     length,
+			Duration: duration,
 		})
 	}
 
+	// The media objects are often the same as the enclosures.
+	for _, enclosure := range r.Element.Enclosures() {
+		if !enclosures.Contains(enclosure.URL) {
+			enclosures = append(enclosures, enclosure)
+		}
+	}
+
 	return enclosures
 }
 
@@ -239,9 +327,18 @@ func (r *rssItem) Transform() *model.Entry {
 	entry.Date = r.PublishedDate()
 	entry.Author = r.Author()
 	entry.Hash = r.Hash()
-	entry.Content = r.Content()
+	entry.Content = r.EmbedPlayer(r.Content())
 	entry.Title = strings.TrimSpace(r.Title)
 	entry.Enclosures = r.Enclosures()
+	entry.ThumbnailURL = r.ThumbnailURL()
+	if entry.ThumbnailURL == "" {
+		entry.ThumbnailURL = strings.TrimSpace(r.ItunesImage.Href)
+	}
+	entry.Subtitle = strings.TrimSpace(r.ItunesSubtitle)
+	entry.Episode = parseItunesNumber(r.ItunesEpisode)
+	entry.Season = parseItunesNumber(r.ItunesSeason)
+	entry.Explicit = parseItunesExplicit(r.ItunesExplicit)
+	entry.AddCategories(r.Categories...)
 	return entry
 }
 
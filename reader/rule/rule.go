@@ -0,0 +1,223 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rule // import "miniflux.app/reader/rule"
+
+import (
+	"regexp"
+	"time"
+
+	"miniflux.app/integration"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/storage"
+)
+
+// matcher evaluates the conditions of a rule with its regular expressions compiled.
+type matcher struct {
+	rule    *model.Rule
+	title   *regexp.Regexp
+	content *regexp.Regexp
+	author  *regexp.Regexp
+}
+
+func newMatcher(rule *model.Rule) (*matcher, error) {
+	m := &matcher{rule: rule}
+	patterns := []struct {
+		expression string
+		compiled   **regexp.Regexp
+	}{
+		{rule.TitlePattern, &m.title},
+		{rule.ContentPattern, &m.content},
+		{rule.AuthorPattern, &m.author},
+	}
+
+	for _, pattern := range patterns {
+		if pattern.expression == "" {
+			continue
+		}
+
+		compiled, err := regexp.Compile(pattern.expression)
+		if err != nil {
+			return nil, err
+		}
+		*pattern.compiled = compiled
+	}
+
+	return m, nil
+}
+
+// match returns true if the entry, published in the given category, satisfies all the conditions of the rule.
+func (m *matcher) match(entry *model.Entry, categoryID int64, now time.Time) bool {
+	if m.rule.FeedID > 0 && entry.FeedID != m.rule.FeedID {
+		return false
+	}
+
+	if m.rule.CategoryID > 0 && categoryID != m.rule.CategoryID {
+		return false
+	}
+
+	if m.rule.OlderThan > 0 && entry.Date.After(now.AddDate(0, 0, -m.rule.OlderThan)) {
+		return false
+	}
+
+	if m.title != nil && !m.title.MatchString(entry.Title) {
+		return false
+	}
+
+	if m.author != nil && !m.author.MatchString(entry.Author) {
+		return false
+	}
+
+	if m.content != nil && !m.content.MatchString(sanitizer.StripTags(entry.Content)) {
+		return false
+	}
+
+	return true
+}
+
+// ApplyRules runs the rules of the user on the entries created by a feed refresh.
+func ApplyRules(store *storage.Storage, feed *model.Feed, entries model.Entries) {
+	if len(entries) == 0 {
+		return
+	}
+
+	rules, err := store.Rules(feed.UserID)
+	if err != nil {
+		logger.Error("[Rule] %v", err)
+		return
+	}
+
+	var categoryID int64
+	if feed.Category != nil {
+		categoryID = feed.Category.ID
+	}
+
+	now := time.Now()
+	for _, rule := range rules {
+		m, err := newMatcher(rule)
+		if err != nil {
+			logger.Error("[Rule] Rule #%d: %v", rule.ID, err)
+			continue
+		}
+
+		var matches model.Entries
+		for _, entry := range entries {
+			if m.match(entry, categoryID, now) {
+				matches = append(matches, entry)
+			}
+		}
+
+		if err := execute(store, rule, matches); err != nil {
+			logger.Error("[Rule] Rule #%d: %v", rule.ID, err)
+		}
+	}
+}
+
+// applyRuleBatchSize is the number of existing entries loaded at once when a rule is applied.
+const applyRuleBatchSize = 500
+
+// ApplyRule runs a rule on the existing entries of the user and returns the number of entries matched.
+// The entries are loaded by batches, from the most recent to the oldest, to keep the memory usage low.
+func ApplyRule(store *storage.Storage, rule *model.Rule) (int, error) {
+	m, err := newMatcher(rule)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	matched := 0
+	var lastEntryID int64
+
+	for {
+		builder := store.NewEntryQueryBuilder(rule.UserID)
+		builder.WithoutStatus(model.EntryStatusRemoved)
+		builder.BeforeEntryID(lastEntryID)
+		builder.WithOrder("e.id")
+		builder.WithDirection("desc")
+		builder.WithLimit(applyRuleBatchSize)
+
+		if rule.FeedID > 0 {
+			builder.WithFeedID(rule.FeedID)
+		}
+
+		if rule.CategoryID > 0 {
+			builder.WithCategoryID(rule.CategoryID)
+		}
+
+		if rule.OlderThan > 0 {
+			builder.BeforeDate(now.AddDate(0, 0, -rule.OlderThan))
+		}
+
+		entries, err := builder.GetEntries()
+		if err != nil {
+			return matched, err
+		}
+
+		var matches model.Entries
+		for _, entry := range entries {
+			if m.match(entry, entry.Feed.Category.ID, now) {
+				matches = append(matches, entry)
+			}
+		}
+
+		if err := execute(store, rule, matches); err != nil {
+			return matched, err
+		}
+
+		matched += len(matches)
+		if len(entries) < applyRuleBatchSize {
+			return matched, nil
+		}
+
+		lastEntryID = entries[len(entries)-1].ID
+	}
+}
+
+// execute runs the actions of the rule on the matching entries and updates its counter.
+func execute(store *storage.Storage, rule *model.Rule, entries model.Entries) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	entryIDs := make([]int64, len(entries))
+	for i, entry := range entries {
+		entryIDs[i] = entry.ID
+	}
+
+	if rule.MarkAsRead {
+		if err := store.SetEntriesStatus(rule.UserID, entryIDs, model.EntryStatusRead); err != nil {
+			return err
+		}
+	}
+
+	if rule.Star {
+		if err := store.SetEntriesStarred(rule.UserID, entryIDs, true); err != nil {
+			return err
+		}
+	}
+
+	if rule.Tag != "" {
+		if err := store.AddEntriesTag(rule.UserID, entryIDs, rule.Tag); err != nil {
+			return err
+		}
+	}
+
+	if rule.SendToIntegrations {
+		settings, err := store.Integration(rule.UserID)
+		if err != nil {
+			return err
+		}
+
+		// The integrations are slow third-party services, they must not block the refresh or the HTTP request.
+		go func() {
+			for _, entry := range entries {
+				integration.SendEntry(entry, settings)
+			}
+		}()
+	}
+
+	return store.IncrementRuleMatchCount(rule.ID, len(entries))
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rule // import "miniflux.app/reader/rule"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestMatchAllConditions(t *testing.T) {
+	now := time.Now()
+	m, err := newMatcher(&model.Rule{
+		FeedID:         1,
+		CategoryID:     2,
+		TitlePattern:   "(?i)golang",
+		ContentPattern: "release",
+		AuthorPattern:  "^Bob$",
+		OlderThan:      7,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := &model.Entry{
+		FeedID:  1,
+		Title:   "GoLang 1.13",
+		Content: "<p>A new <b>release</b></p>",
+		Author:  "Bob",
+		Date:    now.AddDate(0, 0, -10),
+	}
+
+	if !m.match(entry, 2, now) {
+		t.Error(`The entry should match all the conditions`)
+	}
+
+	if m.match(entry, 3, now) {
+		t.Error(`The entry of another category should not match`)
+	}
+
+	entry.Date = now.AddDate(0, 0, -1)
+	if m.match(entry, 2, now) {
+		t.Error(`A recent entry should not match`)
+	}
+}
+
+func TestMatchIgnoresEmptyConditions(t *testing.T) {
+	m, err := newMatcher(&model.Rule{AuthorPattern: "Alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !m.match(&model.Entry{FeedID: 42, Title: "Anything", Author: "Alice"}, 5, time.Now()) {
+		t.Error(`Only the author should be compared`)
+	}
+
+	if m.match(&model.Entry{Author: "Bob"}, 0, time.Now()) {
+		t.Error(`Another author should not match`)
+	}
+}
+
+func TestMatchContentWithoutTags(t *testing.T) {
+	m, err := newMatcher(&model.Rule{ContentPattern: "strong"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.match(&model.Entry{Content: "<strong>Text</strong>"}, 0, time.Now()) {
+		t.Error(`The HTML tags should not be matched`)
+	}
+}
+
+func TestNewMatcherWithInvalidPattern(t *testing.T) {
+	if _, err := newMatcher(&model.Rule{TitlePattern: "(unclosed"}); err == nil {
+		t.Error(`An invalid regular expression should return an error`)
+	}
+}
@@ -5,7 +5,7 @@
 package scraper // import "miniflux.app/reader/scraper"
 
 // List of predefined scraper rules (alphabetically sorted)
-// domain => CSS selectors
+// domain => CSS selectors of the content ! CSS selectors of the elements to remove
 var predefinedRules = map[string]string{
 	"bbc.co.uk":           "div.vxp-column--single, div.story-body__inner, ul.gallery-images__list",
 	"cbc.ca":              ".story-content",
@@ -25,7 +25,7 @@ var predefinedRules = map[string]string{
 	"medium.com":          ".section-content",
 	"mac4ever.com":        "div[itemprop=articleBody]",
 	"monwindows.com":      ".blog-post-body",
-	"npr.org":             "#storytext",
+	"npr.org":             "#storytext ! .internallink",
 	"oneindia.com":        ".io-article-body",
 	"opensource.com":      "div[property]",
 	"osnews.com":          "div.newscontent1",
@@ -12,6 +12,7 @@ import (
 
 	"miniflux.app/http/client"
 	"miniflux.app/logger"
+	"miniflux.app/model"
 	"miniflux.app/reader/readability"
 	"miniflux.app/url"
 
@@ -19,8 +20,12 @@ import (
 )
 
 // Fetch downloads a web page and returns relevant contents.
-func Fetch(websiteURL, rules, userAgent string) (string, error) {
+// Without custom rules, the site rules are used before the predefined rules.
+func Fetch(websiteURL, rules, userAgent, proxyURL string, headers map[string]string, cookie string, siteRules model.SiteRules) (string, error) {
 	clt := client.New(websiteURL)
+	clt.WithProxy(proxyURL)
+	clt.WithHeaders(headers)
+	clt.WithCookie(cookie)
 	if userAgent != "" {
 		clt.WithUserAgent(userAgent)
 	}
@@ -46,31 +51,53 @@ func Fetch(websiteURL, rules, userAgent string) (string, error) {
 	websiteURL = response.EffectiveURL
 
 	if rules == "" {
-		rules = getPredefinedScraperRules(websiteURL)
+		rules = getPredefinedScraperRules(websiteURL, siteRules)
 	}
 
-	var content string
 	if rules != "" {
 		logger.Debug(`[Scraper] Using rules %q for %q`, rules, websiteURL)
-		content, err = scrapContent(response.Body, rules)
-	} else {
+	}
+
+	includeRules, excludeRules := splitRules(rules)
+	if includeRules == "" {
 		logger.Debug(`[Scraper] Using readability for %q`, websiteURL)
-		content, err = readability.ExtractContent(response.Body)
 	}
 
-	if err != nil {
-		return "", err
+	return extractContent(response.Body, includeRules, excludeRules)
+}
+
+// splitRules returns the selectors of the elements to extract and the selectors of the elements to remove.
+// The selectors of the elements to remove come after a "!", for example "article.post ! .share-bar, .newsletter".
+func splitRules(rules string) (includeRules, excludeRules string) {
+	parts := strings.SplitN(rules, "!", 2)
+	includeRules = strings.TrimSpace(parts[0])
+	if len(parts) == 2 {
+		excludeRules = strings.TrimSpace(parts[1])
 	}
 
-	return content, nil
+	return includeRules, excludeRules
 }
 
-func scrapContent(page io.Reader, rules string) (string, error) {
+// extractContent removes the excluded elements from the page, then extracts the included elements.
+// Readability is used when there is no selector of elements to extract.
+func extractContent(page io.Reader, includeRules, excludeRules string) (string, error) {
 	document, err := goquery.NewDocumentFromReader(page)
 	if err != nil {
 		return "", err
 	}
 
+	if excludeRules != "" {
+		document.Find(excludeRules).Remove()
+	}
+
+	if includeRules != "" {
+		return scrapContent(document, includeRules), nil
+	}
+
+	return readability.ExtractContentFromDocument(document), nil
+}
+
+func scrapContent(document *goquery.Document, rules string) string {
 	contents := ""
 	document.Find(rules).Each(func(i int, s *goquery.Selection) {
 		var content string
@@ -85,10 +112,30 @@ func scrapContent(page io.Reader, rules string) (string, error) {
 		contents += content
 	})
 
-	return contents, nil
+	return contents
+}
+
+// PredefinedRules returns a copy of the scraper rules built into the application, by domain.
+func PredefinedRules() map[string]string {
+	rules := make(map[string]string, len(predefinedRules))
+	for domain, rule := range predefinedRules {
+		rules[domain] = rule
+	}
+	return rules
 }
 
-func getPredefinedScraperRules(websiteURL string) string {
+func getPredefinedScraperRules(websiteURL string, siteRules model.SiteRules) string {
+	if siteRule := siteRules.Match(websiteURL); siteRule != nil {
+		if siteRule.Disabled {
+			return ""
+		}
+
+		// A site rule without scraper rules keeps the predefined ones.
+		if siteRule.ScraperRules != "" {
+			return siteRule.ScraperRules
+		}
+	}
+
 	urlDomain := url.Domain(websiteURL)
 
 	for domain, rules := range predefinedRules {
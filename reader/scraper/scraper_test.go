@@ -4,22 +4,52 @@
 
 package scraper // import "miniflux.app/reader/scraper"
 
-import "testing"
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
 
 func TestGetPredefinedRules(t *testing.T) {
-	if getPredefinedScraperRules("http://www.phoronix.com/") == "" {
+	if getPredefinedScraperRules("http://www.phoronix.com/", nil) == "" {
 		t.Error("Unable to find rule for phoronix.com")
 	}
 
-	if getPredefinedScraperRules("https://www.linux.com/") == "" {
+	if getPredefinedScraperRules("https://www.linux.com/", nil) == "" {
 		t.Error("Unable to find rule for linux.com")
 	}
 
-	if getPredefinedScraperRules("https://example.org/") != "" {
+	if getPredefinedScraperRules("https://example.org/", nil) != "" {
 		t.Error("A rule not defined should not return anything")
 	}
 }
 
+func TestGetPredefinedRulesWithSiteRules(t *testing.T) {
+	siteRules := model.SiteRules{
+		{ID: 1, Global: true, Domain: "phoronix.com", Disabled: true},
+		{ID: 2, UserID: 1, Domain: "example.org", ScraperRules: "article"},
+		{ID: 3, UserID: 1, Domain: "lemonde.fr", RewriteRules: "nl2br"},
+	}
+
+	if rules := getPredefinedScraperRules("http://www.phoronix.com/", siteRules); rules != "" {
+		t.Errorf(`A disabled site rule should disable the predefined rule, got %q`, rules)
+	}
+
+	if rules := getPredefinedScraperRules("https://example.org/", siteRules); rules != "article" {
+		t.Errorf(`The site rule should be used, got %q`, rules)
+	}
+
+	if rules := getPredefinedScraperRules("https://www.lemonde.fr/", siteRules); rules != "section.article__content" {
+		t.Errorf(`A site rule without scraper rules should keep the predefined rule, got %q`, rules)
+	}
+
+	if getPredefinedScraperRules("https://www.linux.com/", siteRules) == "" {
+		t.Error("The predefined rule should be used without site rule")
+	}
+}
+
 func TestWhitelistedContentTypes(t *testing.T) {
 	scenarios := map[string]bool{
 		"text/html":                            true,
@@ -40,3 +70,83 @@ func TestWhitelistedContentTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitRules(t *testing.T) {
+	scenarios := []struct {
+		rules, include, exclude string
+	}{
+		{"", "", ""},
+		{"article.post", "article.post", ""},
+		{"article.post ! .share-bar, .newsletter", "article.post", ".share-bar, .newsletter"},
+		{"! .share-bar", "", ".share-bar"},
+		{" div.content !", "div.content", ""},
+	}
+
+	for _, scenario := range scenarios {
+		include, exclude := splitRules(scenario.rules)
+		if include != scenario.include || exclude != scenario.exclude {
+			t.Errorf(`Unexpected rules for %q, got %q and %q instead of %q and %q`,
+				scenario.rules, include, exclude, scenario.include, scenario.exclude)
+		}
+	}
+}
+
+func TestExtractContent(t *testing.T) {
+	scenarios := []struct {
+		name        string
+		rules       string
+		contains    []string
+		notContains []string
+	}{
+		{
+			"include rules",
+			"div.post-body",
+			[]string{"The first paragraph", "Subscribe to our newsletter", "The last paragraph"},
+			[]string{"Share on Twitter", "Related articles"},
+		},
+		{
+			"include and exclude rules",
+			"article.post ! .share-bar, .newsletter, .related",
+			[]string{"The first paragraph", "The last paragraph"},
+			[]string{"Share on Twitter", "Subscribe to our newsletter", "Related articles"},
+		},
+		{
+			"readability",
+			"",
+			[]string{"The first paragraph", "Subscribe to our newsletter", "The last paragraph"},
+			[]string{"Copyright"},
+		},
+		{
+			"readability and exclude rules",
+			"! .newsletter",
+			[]string{"The first paragraph", "The last paragraph"},
+			[]string{"Subscribe to our newsletter", "Copyright"},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		page, err := os.Open("testdata/article.html")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		include, exclude := splitRules(scenario.rules)
+		content, err := extractContent(page, include, exclude)
+		page.Close()
+		if err != nil {
+			t.Fatalf(`Unable to extract content with %s: %v`, scenario.name, err)
+		}
+
+		for _, text := range scenario.contains {
+			if !strings.Contains(content, text) {
+				t.Errorf(`The content extracted with %s should contain %q: %s`, scenario.name, text, content)
+			}
+		}
+
+		for _, text := range scenario.notContains {
+			if strings.Contains(content, text) {
+				t.Errorf(`The content extracted with %s should not contain %q: %s`, scenario.name, text, content)
+			}
+		}
+	}
+}
@@ -22,10 +22,13 @@ var (
 )
 
 // FindSubscriptions downloads and try to find one or more subscriptions from an URL.
-func FindSubscriptions(websiteURL, userAgent, username, password string) (Subscriptions, *errors.LocalizedError) {
+func FindSubscriptions(websiteURL, userAgent, username, password, proxyURL string, headers map[string]string, cookie string) (Subscriptions, *errors.LocalizedError) {
 	request := client.New(websiteURL)
 	request.WithCredentials(username, password)
 	request.WithUserAgent(userAgent)
+	request.WithProxy(proxyURL)
+	request.WithHeaders(headers)
+	request.WithCookie(cookie)
 	response, err := browser.Exec(request)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,96 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package urlcleaner // import "miniflux.app/reader/urlcleaner"
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// RemoveTrackingParameters removes the query parameters matching the given names from the URL.
+// A name ending with "*" matches all the parameters starting with this prefix, e.g. "utm_*".
+// The URL is returned unchanged when it is invalid or when there is nothing to remove.
+func RemoveTrackingParameters(inputURL string, parameters []string) string {
+	if inputURL == "" || len(parameters) == 0 {
+		return inputURL
+	}
+
+	u, err := url.Parse(inputURL)
+	if err != nil || u.RawQuery == "" {
+		return inputURL
+	}
+
+	// The query string is filtered manually to keep the order and the encoding of the other parameters.
+	fields := strings.Split(u.RawQuery, "&")
+	kept := make([]string, 0, len(fields))
+	for _, field := range fields {
+		name := field
+		if index := strings.Index(field, "="); index >= 0 {
+			name = field[:index]
+		}
+
+		if decodedName, err := url.QueryUnescape(name); err == nil {
+			name = decodedName
+		}
+
+		if !isTrackingParameter(name, parameters) {
+			kept = append(kept, field)
+		}
+	}
+
+	if len(kept) == len(fields) {
+		return inputURL
+	}
+
+	u.RawQuery = strings.Join(kept, "&")
+	u.ForceQuery = false
+	return u.String()
+}
+
+// RemoveTrackingParametersFromContent removes the tracking parameters from the links of the HTML content.
+func RemoveTrackingParametersFromContent(content string, parameters []string) string {
+	if content == "" || len(parameters) == 0 || !strings.Contains(content, "?") {
+		return content
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return content
+	}
+
+	modified := false
+	doc.Find("a[href]").Each(func(i int, link *goquery.Selection) {
+		href, _ := link.Attr("href")
+		if cleanedHref := RemoveTrackingParameters(href, parameters); cleanedHref != href {
+			link.SetAttr("href", cleanedHref)
+			modified = true
+		}
+	})
+
+	if !modified {
+		return content
+	}
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+func isTrackingParameter(name string, parameters []string) bool {
+	name = strings.ToLower(name)
+	for _, parameter := range parameters {
+		parameter = strings.ToLower(parameter)
+		if strings.HasSuffix(parameter, "*") {
+			if strings.HasPrefix(name, strings.TrimSuffix(parameter, "*")) {
+				return true
+			}
+		} else if name == parameter {
+			return true
+		}
+	}
+
+	return false
+}
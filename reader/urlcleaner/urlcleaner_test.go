@@ -0,0 +1,59 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package urlcleaner // import "miniflux.app/reader/urlcleaner"
+
+import "testing"
+
+var testParameters = []string{"utm_*", "fbclid", "gclid", "mc_eid"}
+
+func TestRemoveTrackingParameters(t *testing.T) {
+	scenarios := map[string]string{
+		"":                                      "",
+		"https://example.org/":                  "https://example.org/",
+		"https://example.org/?id=1":             "https://example.org/?id=1",
+		"https://example.org/?utm_source=rss":   "https://example.org/",
+		"https://example.org/?UTM_Medium=feed":  "https://example.org/",
+		"https://example.org/?fbclid=abc#title": "https://example.org/#title",
+		"https://example.org/a?b=2&utm_source=rss&a=1&gclid=x": "https://example.org/a?b=2&a=1",
+		"https://example.org/?q=a%20b&mc_eid=1":                "https://example.org/?q=a%20b",
+		"https://example.org/?utm%5Fsource=rss":                "https://example.org/",
+		"https://example.org/?fbclid_other=1":                  "https://example.org/?fbclid_other=1",
+		"/relative/path?utm_campaign=test&page=2":              "/relative/path?page=2",
+		"mailto:someone@example.org?subject=hello":             "mailto:someone@example.org?subject=hello",
+	}
+
+	for input, expected := range scenarios {
+		actual := RemoveTrackingParameters(input, testParameters)
+		if actual != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
+
+func TestRemoveTrackingParametersWithoutParameters(t *testing.T) {
+	input := "https://example.org/?utm_source=rss"
+	if output := RemoveTrackingParameters(input, nil); output != input {
+		t.Errorf(`The URL should not be modified, got %q`, output)
+	}
+}
+
+func TestRemoveTrackingParametersFromContent(t *testing.T) {
+	input := `<p><a href="https://example.org/?utm_source=rss&amp;id=1">Link</a> <a href="https://example.org/other">Other</a></p>`
+	expected := `<p><a href="https://example.org/?id=1">Link</a> <a href="https://example.org/other">Other</a></p>`
+	output := RemoveTrackingParametersFromContent(input, testParameters)
+
+	if output != expected {
+		t.Errorf(`Unexpected output, got %q instead of %q`, output, expected)
+	}
+}
+
+func TestRemoveTrackingParametersFromContentWithoutTrackingLinks(t *testing.T) {
+	input := `<p><a href="https://example.org/?id=1">Link</a><br></p>`
+	output := RemoveTrackingParametersFromContent(input, testParameters)
+
+	if output != input {
+		t.Errorf(`The content should not be modified, got %q`, output)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package urlresolver // import "miniflux.app/reader/urlresolver"
+
+import (
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/client"
+)
+
+// Resolve follows the redirections of the URL and returns the final destination.
+// A HEAD request is sent first, some servers do not support it, a GET request is sent in that case.
+func Resolve(inputURL, userAgent, proxyURL string, headers map[string]string, cookie string) (string, error) {
+	response, err := newClient(inputURL, userAgent, proxyURL, headers, cookie).Head()
+	if err != nil || response.StatusCode >= http.StatusBadRequest {
+		response, err = newClient(inputURL, userAgent, proxyURL, headers, cookie).Get()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("urlresolver: unable to resolve %q (status code %d)", inputURL, response.StatusCode)
+	}
+
+	return response.EffectiveURL, nil
+}
+
+func newClient(inputURL, userAgent, proxyURL string, headers map[string]string, cookie string) *client.Client {
+	clt := client.New(inputURL)
+	clt.WithProxy(proxyURL)
+	clt.WithHeaders(headers)
+	clt.WithCookie(cookie)
+	clt.WithUserAgent(userAgent)
+	return clt
+}
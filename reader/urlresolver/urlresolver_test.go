@@ -0,0 +1,79 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package urlresolver // import "miniflux.app/reader/urlresolver"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func TestResolveRedirections(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/~r/feed/abc", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/click?id=1", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/click", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/2019/article.html", http.StatusFound)
+	})
+	mux.HandleFunc("/2019/article.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("article"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	finalURL, err := Resolve(server.URL+"/~r/feed/abc", "", "", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if finalURL != server.URL+"/2019/article.html" {
+		t.Fatalf(`Unexpected URL, got %q`, finalURL)
+	}
+}
+
+func TestResolveWhenHeadIsNotAllowed(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/short", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		http.Redirect(w, r, "/article", http.StatusFound)
+	})
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("article"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	finalURL, err := Resolve(server.URL+"/short", "", "", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if finalURL != server.URL+"/article" {
+		t.Fatalf(`Unexpected URL, got %q`, finalURL)
+	}
+}
+
+func TestResolveWithBrokenLink(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	if _, err := Resolve(server.URL+"/missing", "", "", nil, ""); err == nil {
+		t.Fatal(`An error should be returned for broken links`)
+	}
+}
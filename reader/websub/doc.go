@@ -0,0 +1,8 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+Package websub implements the subscriber side of the WebSub protocol (formerly PubSubHubbub).
+*/
+package websub // import "miniflux.app/reader/websub"
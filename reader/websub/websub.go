@@ -0,0 +1,116 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websub // import "miniflux.app/reader/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+)
+
+// LeaseSeconds is the subscription duration requested to the hubs, longer leases granted by a hub are shortened.
+const LeaseSeconds = 10 * 24 * 3600
+
+// VerificationTimeout is the time given to the hub to verify the intent of a request.
+const VerificationTimeout = time.Hour
+
+// LeaseDuration returns the lease granted by the hub, the value of hub.lease_seconds is bounded by LeaseSeconds.
+func LeaseDuration(value string) time.Duration {
+	leaseSeconds, err := strconv.Atoi(value)
+	if err != nil || leaseSeconds <= 0 || leaseSeconds > LeaseSeconds {
+		leaseSeconds = LeaseSeconds
+	}
+
+	return time.Duration(leaseSeconds) * time.Second
+}
+
+// CallbackURL returns the URL where the hub sends verification requests and notifications for the given feed.
+func CallbackURL(feedID int64) string {
+	return fmt.Sprintf("%s/websub/%d", config.Opts.BaseURL(), feedID)
+}
+
+// Subscribe asks the hub to push the updates of the feed.
+// The hub confirms the subscription asynchronously by calling the callback URL.
+func Subscribe(feed *model.Feed) error {
+	return sendRequest(feed, "subscribe")
+}
+
+// Unsubscribe asks the hub to stop pushing the updates of the feed.
+func Unsubscribe(feed *model.Feed) error {
+	return sendRequest(feed, "unsubscribe")
+}
+
+func sendRequest(feed *model.Feed, mode string) error {
+	values := url.Values{}
+	values.Set("hub.mode", mode)
+	values.Set("hub.topic", feed.WebSubTopicURL())
+	values.Set("hub.callback", CallbackURL(feed.ID))
+
+	if mode == "subscribe" {
+		values.Set("hub.secret", feed.HubSecret)
+		values.Set("hub.lease_seconds", strconv.Itoa(LeaseSeconds))
+	}
+
+	request := client.New(feed.HubURL)
+	request.WithUserAgent(feed.UserAgent)
+	request.WithProxy(feed.ProxyURL)
+	response, err := request.PostForm(values)
+	if err != nil {
+		return fmt.Errorf("websub: unable to %s to hub %q: %v", mode, feed.HubURL, err)
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("websub: hub %q refused to %s (status=%d): %s", feed.HubURL, mode, response.StatusCode, response.String())
+	}
+
+	return nil
+}
+
+// ValidSignature checks the X-Hub-Signature header of a notification, as described in the WebSub specification.
+func ValidSignature(secret, signature string, body []byte) bool {
+	if secret == "" {
+		return false
+	}
+
+	parts := strings.SplitN(signature, "=", 2)
+	if len(parts) != 2 {
+		return false
+	}
+
+	var h func() hash.Hash
+	switch strings.ToLower(parts[0]) {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	case "sha384":
+		h = sha512.New384
+	case "sha512":
+		h = sha512.New
+	default:
+		return false
+	}
+
+	expected, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websub // import "miniflux.app/reader/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+func sign(h func() hash.Hash, secret, body string) string {
+	mac := hmac.New(h, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidSignature(t *testing.T) {
+	body := `<feed></feed>`
+
+	scenarios := map[string]bool{
+		"sha1=" + sign(sha1.New, "secret", body):     true,
+		"sha256=" + sign(sha256.New, "secret", body): true,
+		"SHA1=" + sign(sha1.New, "secret", body):     true,
+		"sha1=" + sign(sha1.New, "other", body):      false,
+		"md5=" + sign(sha1.New, "secret", body):      false,
+		"sha1=invalid":                               false,
+		"sha1":                                       false,
+		"":                                           false,
+	}
+
+	for signature, expected := range scenarios {
+		if actual := ValidSignature("secret", signature, []byte(body)); actual != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, signature, actual, expected)
+		}
+	}
+}
+
+func TestValidSignatureWithoutSecret(t *testing.T) {
+	body := `<feed></feed>`
+	if ValidSignature("", "sha1="+sign(sha1.New, "", body), []byte(body)) {
+		t.Error(`A notification should never be valid without secret`)
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	var form map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		form = map[string]string{}
+		for key := range r.PostForm {
+			form[key] = r.PostForm.Get(key)
+		}
+		ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	feed := &model.Feed{ID: 42, FeedURL: "https://example.org/feed.xml", HubURL: server.URL, HubSecret: "secret"}
+	if err := Subscribe(feed); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"hub.mode":          "subscribe",
+		"hub.topic":         "https://example.org/feed.xml",
+		"hub.callback":      "http://localhost/websub/42",
+		"hub.secret":        "secret",
+		"hub.lease_seconds": "864000",
+	}
+
+	for key, value := range expected {
+		if form[key] != value {
+			t.Errorf(`Unexpected value for %q, got %q instead of %q`, key, form[key], value)
+		}
+	}
+}
+
+func TestSubscribeRefused(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	feed := &model.Feed{ID: 42, FeedURL: "https://example.org/feed.xml", HubURL: server.URL, HubSecret: "secret"}
+	if err := Subscribe(feed); err == nil {
+		t.Fatal(`An error should be returned when the hub refuses the subscription`)
+	}
+}
+
+func TestLeaseDuration(t *testing.T) {
+	scenarios := map[string]time.Duration{
+		"3600":                 time.Hour,
+		"":                     LeaseSeconds * time.Second,
+		"invalid":              LeaseSeconds * time.Second,
+		"-1":                   LeaseSeconds * time.Second,
+		"31536000000":          LeaseSeconds * time.Second,
+		"99999999999999999999": LeaseSeconds * time.Second,
+	}
+
+	for value, expected := range scenarios {
+		if actual := LeaseDuration(value); actual != expected {
+			t.Errorf(`Unexpected lease for %q, got %v instead of %v`, value, actual, expected)
+		}
+	}
+}
@@ -169,6 +169,10 @@ func setupHandler(store *storage.Storage, feedHandler *feed.Handler, pool *worke
 	api.Serve(router, store, feedHandler)
 	ui.Serve(router, store, pool, feedHandler)
 
+	websubHandler := &websubHandler{store: store, feedHandler: feedHandler}
+	router.HandleFunc("/websub/{feedID:[0-9]+}", websubHandler.verify).Name("websubVerify").Methods("GET")
+	router.HandleFunc("/websub/{feedID:[0-9]+}", websubHandler.notify).Name("websubNotify").Methods("POST")
+
 	router.HandleFunc("/healthcheck", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("OK"))
 	}).Name("healthcheck")
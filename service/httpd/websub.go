@@ -0,0 +1,144 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package httpd // import "miniflux.app/service/httpd"
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/feed"
+	"miniflux.app/reader/websub"
+	"miniflux.app/storage"
+)
+
+// websubHandler receives the verification requests and the notifications sent by WebSub hubs.
+type websubHandler struct {
+	store       *storage.Storage
+	feedHandler *feed.Handler
+}
+
+func (h *websubHandler) findFeed(w http.ResponseWriter, r *http.Request) *model.Feed {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID, err := h.store.FeedUserID(feedID)
+	if err != nil {
+		logger.Error("[WebSub] %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil
+	}
+
+	if userID == 0 {
+		// Hubs should stop sending notifications for a feed that doesn't exist anymore.
+		w.WriteHeader(http.StatusGone)
+		return nil
+	}
+
+	subscription, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		logger.Error("[WebSub] %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil
+	}
+
+	if subscription == nil || subscription.HubURL == "" {
+		w.WriteHeader(http.StatusGone)
+		return nil
+	}
+
+	return subscription
+}
+
+// verify answers the verification of intent sent by the hub after a subscription request.
+// Only the request sent by Miniflux and still waiting for its verification is confirmed.
+func (h *websubHandler) verify(w http.ResponseWriter, r *http.Request) {
+	subscription := h.findFeed(w, r)
+	if subscription == nil {
+		return
+	}
+
+	mode := r.URL.Query().Get("hub.mode")
+	topic := r.URL.Query().Get("hub.topic")
+	challenge := r.URL.Query().Get("hub.challenge")
+
+	expectedMode := mode
+	if mode == "denied" {
+		expectedMode = "subscribe"
+	}
+
+	pendingMode, err := h.store.FeedHubPendingMode(subscription)
+	if err != nil {
+		logger.Error("[WebSub] %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if pendingMode == "" || pendingMode != expectedMode || topic != subscription.WebSubTopicURL() {
+		logger.Info("[WebSub] Feed #%d: unexpected %s request for topic %q", subscription.ID, mode, topic)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch mode {
+	case "subscribe":
+		if subscription.HubSecret == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		subscription.HubLeaseExpiresAt = time.Now().Add(websub.LeaseDuration(r.URL.Query().Get("hub.lease_seconds")))
+		logger.Info("[WebSub] Feed #%d: subscription confirmed until %v", subscription.ID, subscription.HubLeaseExpiresAt)
+	case "unsubscribe":
+		subscription.HubLeaseExpiresAt = time.Time{}
+		logger.Info("[WebSub] Feed #%d: unsubscribed", subscription.ID)
+	case "denied":
+		subscription.HubLeaseExpiresAt = time.Time{}
+		logger.Info("[WebSub] Feed #%d: subscription denied by the hub (%s)", subscription.ID, r.URL.Query().Get("hub.reason"))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.store.UpdateFeedHubLease(subscription); err != nil {
+		logger.Error("[WebSub] %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte(challenge))
+}
+
+// notify ingests the content distributed by the hub.
+func (h *websubHandler) notify(w http.ResponseWriter, r *http.Request) {
+	subscription := h.findFeed(w, r)
+	if subscription == nil {
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, config.Opts.HTTPClientMaxBodySize()))
+	if err != nil {
+		logger.Error("[WebSub] Feed #%d: unable to read notification: %v", subscription.ID, err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// The specification requires a successful response even when the signature is invalid,
+	// so an attacker cannot find out if the secret is correct.
+	w.WriteHeader(http.StatusAccepted)
+
+	if !websub.ValidSignature(subscription.HubSecret, r.Header.Get("X-Hub-Signature"), body) {
+		logger.Info("[WebSub] Feed #%d: ignoring notification with invalid signature", subscription.ID)
+		return
+	}
+
+	if err := h.feedHandler.PushFeed(subscription.UserID, subscription.ID, string(body)); err != nil {
+		logger.Error("[WebSub] Feed #%d: %v", subscription.ID, err)
+	}
+}
@@ -40,6 +40,9 @@ func cleanupScheduler(store *storage.Storage, frequency int, archiveDays int) {
 		nbUserSessions := store.CleanOldUserSessions()
 		logger.Info("[Scheduler:Cleanup] Cleaned %d sessions and %d user sessions", nbSessions, nbUserSessions)
 
+		nbResolvedURLs := store.CleanOldResolvedURLs()
+		logger.Info("[Scheduler:Cleanup] Cleaned %d resolved URLs", nbResolvedURLs)
+
 		if err := store.ArchiveEntries(archiveDays); err != nil {
 			logger.Error("[Scheduler:Cleanup] %v", err)
 		}
@@ -5,6 +5,7 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"database/sql"
 	"fmt"
 
 	"miniflux.app/model"
@@ -13,7 +14,7 @@ import (
 // GetEnclosures returns all attachments for the given entry.
 func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 	query := `SELECT
-		id, user_id, entry_id, url, size, mime_type
+		id, user_id, entry_id, url, size, mime_type, duration, media_progression
 		FROM enclosures
 		WHERE entry_id = $1 ORDER BY id ASC`
 
@@ -33,6 +34,8 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			&enclosure.URL,
 			&enclosure.Size,
 			&enclosure.MimeType,
+			&enclosure.Duration,
+			&enclosure.MediaProgression,
 		)
 
 		if err != nil {
@@ -45,13 +48,52 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 	return enclosures, nil
 }
 
+// EnclosureByID returns the attachment of the given user.
+func (s *Storage) EnclosureByID(userID, enclosureID int64) (*model.Enclosure, error) {
+	query := `SELECT
+		id, user_id, entry_id, url, size, mime_type, duration, media_progression
+		FROM enclosures
+		WHERE user_id = $1 AND id = $2`
+
+	var enclosure model.Enclosure
+	err := s.db.QueryRow(query, userID, enclosureID).Scan(
+		&enclosure.ID,
+		&enclosure.UserID,
+		&enclosure.EntryID,
+		&enclosure.URL,
+		&enclosure.Size,
+		&enclosure.MimeType,
+		&enclosure.Duration,
+		&enclosure.MediaProgression,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch enclosure #%d: %v", enclosureID, err)
+	}
+
+	return &enclosure, nil
+}
+
+// UpdateEnclosureMediaProgression saves the playback position of an attachment, in seconds.
+func (s *Storage) UpdateEnclosureMediaProgression(userID, enclosureID int64, progression int) error {
+	query := `UPDATE enclosures SET media_progression=$1 WHERE user_id=$2 AND id=$3`
+	if _, err := s.db.Exec(query, progression, userID, enclosureID); err != nil {
+		return fmt.Errorf("unable to update the media progression of enclosure #%d: %v", enclosureID, err)
+	}
+
+	return nil
+}
+
 // CreateEnclosure creates a new attachment.
 func (s *Storage) CreateEnclosure(enclosure *model.Enclosure) error {
 	query := `
 		INSERT INTO enclosures
-		(url, size, mime_type, entry_id, user_id)
+		(url, size, mime_type, entry_id, user_id, duration)
 		VALUES
-		($1, $2, $3, $4, $5)
+		($1, $2, $3, $4, $5, $6)
 		RETURNING id
 	`
 	err := s.db.QueryRow(
@@ -61,6 +103,7 @@ func (s *Storage) CreateEnclosure(enclosure *model.Enclosure) error {
 		enclosure.MimeType,
 		enclosure.EntryID,
 		enclosure.UserID,
+		enclosure.Duration,
 	).Scan(&enclosure.ID)
 
 	if err != nil {
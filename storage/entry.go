@@ -11,6 +11,7 @@ import (
 
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/url"
 
 	"github.com/lib/pq"
 )
@@ -41,13 +42,14 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 		return err
 	}
 
-	_, err = tx.Exec(`UPDATE entries SET content=$1 WHERE id=$2 AND user_id=$3`, entry.Content, entry.ID, entry.UserID)
+	query := `UPDATE entries SET content=$1, reading_time=$2 WHERE id=$3 AND user_id=$4`
+	_, err = tx.Exec(query, entry.Content, entry.ReadingTime, entry.ID, entry.UserID)
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf(`unable to update content of entry #%d: %v`, entry.ID, err)
 	}
 
-	query := `
+	query = `
 		UPDATE entries
 		SET document_vectors = setweight(to_tsvector(substring(coalesce(title, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce(content, '') for 1000000)), 'B')
 		WHERE id=$1 AND user_id=$2
@@ -61,13 +63,24 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 	return tx.Commit()
 }
 
-// createEntry add a new entry.
-func (s *Storage) createEntry(entry *model.Entry) error {
+// createEntry add a new entry, the canonical URL is used to find the same story published by other feeds.
+func (s *Storage) createEntry(entry *model.Entry, canonicalURL string) error {
+	if entry.Status == "" {
+		entry.Status = model.EntryStatusUnread
+	}
+
+	var duplicateOf interface{}
+	if entry.DuplicateOf != 0 {
+		duplicateOf = entry.DuplicateOf
+	}
+
 	query := `
 		INSERT INTO entries
-		(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, document_vectors)
+		(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, crawl_pending,
+		status, duplicate_of, canonical_url, normalized_title, reading_time, thumbnail_url,
+		subtitle, episode, season, explicit, grouped, document_vectors)
 		VALUES
-		($1, $2, $3, $4, $5, $6, $7, $8, $9, setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($6, '') for 1000000)), 'B'))
+		($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($6, '') for 1000000)), 'B'))
 		RETURNING id, status
 	`
 	err := s.db.QueryRow(
@@ -81,6 +94,18 @@ func (s *Storage) createEntry(entry *model.Entry) error {
 		entry.Author,
 		entry.UserID,
 		entry.FeedID,
+		entry.CrawlPending,
+		entry.Status,
+		duplicateOf,
+		nullableString(canonicalURL),
+		model.NormalizeTitle(entry.Title),
+		entry.ReadingTime,
+		entry.ThumbnailURL,
+		entry.Subtitle,
+		entry.Episode,
+		entry.Season,
+		entry.Explicit,
+		entry.Grouped,
 	).Scan(&entry.ID, &entry.Status)
 
 	if err != nil {
@@ -96,34 +121,77 @@ func (s *Storage) createEntry(entry *model.Entry) error {
 		}
 	}
 
-	return nil
+	// The categories given by the feed are stored as tags.
+	return addEntryTags(s.db, entry.UserID, entry.ID, entry.Categories)
 }
 
-// updateEntry updates an entry when a feed is refreshed.
+// updateEntry updates an entry when a feed is refreshed, and returns true if the entry has changed.
+// When the title or the text of the content changes, the previous version is saved as a revision.
+// The revisions are not saved for entries waiting for the crawler, their content is not the final one.
 // Note: we do not update the published date because some feeds do not contains any date,
 // it default to time.Now() which could change the order of items on the history page.
-func (s *Storage) updateEntry(entry *model.Entry) error {
-	query := `
-		UPDATE entries SET
-		title=$1, url=$2, comments_url=$3, content=$4, author=$5,
+func (s *Storage) updateEntry(entry *model.Entry, crawlPending bool) (bool, error) {
+	var oldTitle, oldContent string
+	query := `SELECT id, title, content FROM entries WHERE user_id=$1 AND feed_id=$2 AND hash=$3`
+	if err := s.db.QueryRow(query, entry.UserID, entry.FeedID, entry.Hash).Scan(&entry.ID, &oldTitle, &oldContent); err != nil {
+		return false, fmt.Errorf(`unable to update entry %q: %v`, entry.URL, err)
+	}
+
+	revised := !crawlPending && model.IsRevised(oldTitle, oldContent, entry.Title, entry.Content)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf(`unable to update entry %q: %v`, entry.URL, err)
+	}
+
+	if revised {
+		if err := s.createEntryRevision(tx, entry.ID, oldTitle, oldContent); err != nil {
+			tx.Rollback()
+			return false, err
+		}
+	}
+
+	query = `
+		UPDATE entries e SET
+		title=$1, url=$2, comments_url=$3, content=$4, author=$5, crawl_pending=$7, reading_time=$11, thumbnail_url=$12,
+		subtitle=$13, episode=$14, season=$15, explicit=$16,
+		updated_at = CASE WHEN $8 THEN now() ELSE e.updated_at END,
+		status = CASE WHEN $8 AND e.status=$9 AND f.mark_unread_on_update THEN $10 ELSE e.status END,
 		document_vectors = setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($4, '') for 1000000)), 'B')
-		WHERE user_id=$6 AND feed_id=$7 AND hash=$8
-		RETURNING id
+		FROM (SELECT id, title, url, comments_url, content, author FROM entries WHERE id=$6) AS old, feeds f
+		WHERE e.id=old.id AND f.id=e.feed_id
+		RETURNING
+		old.title IS DISTINCT FROM e.title OR old.url IS DISTINCT FROM e.url OR old.comments_url IS DISTINCT FROM e.comments_url OR
+		old.content IS DISTINCT FROM e.content OR old.author IS DISTINCT FROM e.author
 	`
-	err := s.db.QueryRow(
+	var changed bool
+	err = tx.QueryRow(
 		query,
 		entry.Title,
 		entry.URL,
 		entry.CommentsURL,
 		entry.Content,
 		entry.Author,
-		entry.UserID,
-		entry.FeedID,
-		entry.Hash,
-	).Scan(&entry.ID)
+		entry.ID,
+		entry.CrawlPending,
+		revised,
+		model.EntryStatusRead,
+		model.EntryStatusUnread,
+		entry.ReadingTime,
+		entry.ThumbnailURL,
+		entry.Subtitle,
+		entry.Episode,
+		entry.Season,
+		entry.Explicit,
+	).Scan(&changed)
 
 	if err != nil {
-		return fmt.Errorf(`unable to update entry %q: %v`, entry.URL, err)
+		tx.Rollback()
+		return false, fmt.Errorf(`unable to update entry %q: %v`, entry.URL, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf(`unable to update entry %q: %v`, entry.URL, err)
 	}
 
 	for _, enclosure := range entry.Enclosures {
@@ -131,15 +199,70 @@ func (s *Storage) updateEntry(entry *model.Entry) error {
 		enclosure.EntryID = entry.ID
 	}
 
-	return s.UpdateEnclosures(entry.Enclosures)
+	return changed, s.UpdateEnclosures(entry.Enclosures)
 }
 
 // entryExists checks if an entry already exists based on its hash when refreshing a feed.
-func (s *Storage) entryExists(entry *model.Entry) bool {
-	var result int
-	query := `SELECT count(*) as c FROM entries WHERE user_id=$1 AND feed_id=$2 AND hash=$3`
-	s.db.QueryRow(query, entry.UserID, entry.FeedID, entry.Hash).Scan(&result)
-	return result >= 1
+// The second value is true when the stored entry is waiting to be crawled again.
+func (s *Storage) entryExists(entry *model.Entry) (exists, crawlPending bool) {
+	query := `SELECT crawl_pending FROM entries WHERE user_id=$1 AND feed_id=$2 AND hash=$3`
+	if err := s.db.QueryRow(query, entry.UserID, entry.FeedID, entry.Hash).Scan(&crawlPending); err != nil {
+		return false, false
+	}
+	return true, crawlPending
+}
+
+// findDuplicateEntry returns the ID of the first entry of another feed of the user that publishes the same story.
+// Entries are matched by canonical URL and optionally by normalized title, the hash is not used because GUIDs are only unique within a feed.
+func (s *Storage) findDuplicateEntry(entry *model.Entry, canonicalURL string, matchTitle bool) (entryID int64) {
+	title := model.NormalizeTitle(entry.Title)
+	query := `
+		SELECT id FROM entries
+		WHERE user_id=$1 AND feed_id<>$2 AND duplicate_of IS NULL AND
+		(canonical_url=$3 OR ($4 AND normalized_title=$5))
+		ORDER BY id ASC
+		LIMIT 1
+	`
+	s.db.QueryRow(query, entry.UserID, entry.FeedID, nullableString(canonicalURL), matchTitle && title != "", title).Scan(&entryID)
+	return entryID
+}
+
+// entryCanonicalURL returns the normalized URL of an entry, or an empty string when the URL doesn't identify the story.
+// Entries without link inherit the URL of the website, they must not be merged with the other entries of the feed.
+func entryCanonicalURL(entryURL, feedURL, siteURL string) string {
+	if entryURL == "" {
+		return ""
+	}
+
+	canonicalURL := url.Normalize(entryURL)
+	if canonicalURL == url.Normalize(feedURL) || canonicalURL == url.Normalize(siteURL) {
+		return ""
+	}
+
+	return canonicalURL
+}
+
+// nullableString returns nil for the optional values left empty.
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// feedURLs returns the URL of the feed and the URL of its website.
+func (s *Storage) feedURLs(feedID int64) (feedURL, siteURL string) {
+	s.db.QueryRow(`SELECT feed_url, site_url FROM feeds WHERE id=$1`, feedID).Scan(&feedURL, &siteURL)
+	return feedURL, siteURL
+}
+
+// duplicatePolicy returns how the user wants to handle entries already published by another feed.
+func (s *Storage) duplicatePolicy(userID int64) (policy string, matchTitle bool) {
+	query := `SELECT duplicate_policy, duplicate_match_title FROM users WHERE id=$1`
+	if err := s.db.QueryRow(query, userID).Scan(&policy, &matchTitle); err != nil {
+		return model.DuplicatePolicyNone, false
+	}
+	return policy, matchTitle
 }
 
 // cleanupEntries deletes from the database entries marked as "removed" and not visible anymore in the feed.
@@ -157,32 +280,87 @@ func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
 }
 
 // UpdateEntries updates a list of entries while refreshing a feed.
-func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (err error) {
+// It returns the entries created and the number of entries modified.
+func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (created model.Entries, updated int, err error) {
+	created, updated, err = s.AppendEntries(userID, feedID, entries, updateExistingEntries)
+	if err != nil {
+		return created, updated, err
+	}
+
 	var entryHashes []string
+	for _, entry := range entries {
+		entryHashes = append(entryHashes, entry.Hash)
+	}
+
+	if err := s.cleanupEntries(feedID, entryHashes); err != nil {
+		logger.Error("[Storage:CleanupEntries] feed #%d: %v", feedID, err)
+	}
+
+	return created, updated, nil
+}
+
+// AppendEntries stores a list of entries without removing the ones missing from the list.
+// It is used when the list may be partial, like the content pushed by a WebSub hub.
+// It returns the entries created and the number of entries modified.
+func (s *Storage) AppendEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (created model.Entries, updated int, err error) {
+	duplicatePolicy, matchTitle := s.duplicatePolicy(userID)
+	feedURL, siteURL := s.feedURLs(feedID)
+
 	for _, entry := range entries {
 		entry.UserID = userID
 		entry.FeedID = feedID
 
-		if s.entryExists(entry) {
-			if updateExistingEntries {
-				err = s.updateEntry(entry)
+		if exists, crawlPending := s.entryExists(entry); exists {
+			// Entries waiting for the crawler are updated to store the content crawled during this refresh.
+			if updateExistingEntries || crawlPending {
+				var changed bool
+				if changed, err = s.updateEntry(entry, crawlPending); changed {
+					updated++
+				}
 			}
 		} else {
-			err = s.createEntry(entry)
+			canonicalURL := entryCanonicalURL(entry.URL, feedURL, siteURL)
+			if duplicatePolicy != model.DuplicatePolicyNone {
+				s.applyDuplicatePolicy(entry, canonicalURL, duplicatePolicy, matchTitle)
+			}
+
+			if err = s.createEntry(entry, canonicalURL); err == nil {
+				created = append(created, entry)
+			}
 		}
 
 		if err != nil {
-			return err
+			return created, updated, err
 		}
+	}
 
-		entryHashes = append(entryHashes, entry.Hash)
+	return created, updated, nil
+}
+
+// applyDuplicatePolicy links a new entry to the entry of another feed publishing the same story.
+// Duplicates are marked as read, or grouped: hidden from the lists and only displayed with the first entry.
+// The status of grouped entries is left alone, they are visible again if the first entry is deleted.
+func (s *Storage) applyDuplicatePolicy(entry *model.Entry, canonicalURL, policy string, matchTitle bool) {
+	entry.DuplicateOf = s.findDuplicateEntry(entry, canonicalURL, matchTitle)
+	if entry.DuplicateOf == 0 {
+		return
 	}
 
-	if err := s.cleanupEntries(feedID, entryHashes); err != nil {
-		logger.Error("[Storage:CleanupEntries] feed #%d: %v", feedID, err)
+	switch policy {
+	case model.DuplicatePolicyRead:
+		entry.Status = model.EntryStatusRead
+	case model.DuplicatePolicyGroup:
+		entry.Grouped = true
 	}
+}
 
-	return nil
+// EntryDuplicates returns the other entries publishing the same story as the given entry.
+func (s *Storage) EntryDuplicates(userID int64, entry *model.Entry) (model.Entries, error) {
+	builder := s.NewEntryQueryBuilder(userID)
+	builder.WithDuplicatesOf(entry)
+	builder.WithOrder("e.id")
+	builder.WithDirection("asc")
+	return builder.GetEntries()
 }
 
 // ArchiveEntries changes the status of read items to "removed" after specified days.
@@ -221,6 +399,16 @@ func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string
 	return nil
 }
 
+// SetEntriesStarred updates the bookmark flag of the given list of entries.
+func (s *Storage) SetEntriesStarred(userID int64, entryIDs []int64, starred bool) error {
+	query := `UPDATE entries SET starred=$1 WHERE user_id=$2 AND id=ANY($3)`
+	if _, err := s.db.Exec(query, starred, userID, pq.Array(entryIDs)); err != nil {
+		return fmt.Errorf("unable to update bookmark flag of entries %v: %v", entryIDs, err)
+	}
+
+	return nil
+}
+
 // ToggleBookmark toggles entry bookmark value.
 func (s *Storage) ToggleBookmark(userID int64, entryID int64) error {
 	query := `UPDATE entries SET starred = NOT starred WHERE user_id=$1 AND id=$2`
@@ -305,10 +493,11 @@ func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time)
 	return nil
 }
 
-// EntryURLExists returns true if an entry with this URL already exists.
-func (s *Storage) EntryURLExists(feedID int64, entryURL string) bool {
+// EntryNeedsCrawling returns true if there is no entry with this URL yet,
+// or if the crawler did not finish in time during the previous refresh.
+func (s *Storage) EntryNeedsCrawling(feedID int64, entryURL string) bool {
 	var result int
-	query := `SELECT count(*) as c FROM entries WHERE feed_id=$1 AND url=$2`
+	query := `SELECT count(*) as c FROM entries WHERE feed_id=$1 AND url=$2 AND crawl_pending='f'`
 	s.db.QueryRow(query, feedID, entryURL).Scan(&result)
-	return result >= 1
+	return result == 0
 }
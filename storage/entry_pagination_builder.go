@@ -52,6 +52,14 @@ func (e *EntryPaginationBuilder) WithCategoryID(categoryID int64) {
 	}
 }
 
+// WithTagID adds the tag to the condition.
+func (e *EntryPaginationBuilder) WithTagID(tagID int64) {
+	if tagID != 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.id IN (SELECT entry_id FROM entry_tags WHERE tag_id = $%d)", len(e.args)+1))
+		e.args = append(e.args, tagID)
+	}
+}
+
 // WithStatus adds status to the condition.
 func (e *EntryPaginationBuilder) WithStatus(status string) {
 	if status != "" {
@@ -159,7 +167,7 @@ func NewEntryPaginationBuilder(store *Storage, userID, entryID int64, direction
 	return &EntryPaginationBuilder{
 		store:      store,
 		args:       []interface{}{userID, "removed"},
-		conditions: []string{"e.user_id = $1", "e.status <> $2"},
+		conditions: []string{"e.user_id = $1", "e.status <> $2", groupedDuplicatesCondition},
 		entryID:    entryID,
 		direction:  direction,
 	}
@@ -16,15 +16,20 @@ import (
 	"miniflux.app/timezone"
 )
 
+// groupedDuplicatesCondition hides the entries grouped with the entry of another feed publishing the same story.
+// The entries are visible again when the first entry is deleted.
+const groupedDuplicatesCondition = "NOT (e.grouped AND e.duplicate_of IS NOT NULL)"
+
 // EntryQueryBuilder builds a SQL query to fetch entries.
 type EntryQueryBuilder struct {
-	store      *Storage
-	args       []interface{}
-	conditions []string
-	order      string
-	direction  string
-	limit      int
-	offset     int
+	store          *Storage
+	args           []interface{}
+	conditions     []string
+	order          string
+	direction      string
+	limit          int
+	offset         int
+	withDuplicates bool
 }
 
 // WithSearchQuery adds full-text search query to the condition.
@@ -89,7 +94,21 @@ func (e *EntryQueryBuilder) WithEntryID(entryID int64) *EntryQueryBuilder {
 	if entryID != 0 {
 		e.conditions = append(e.conditions, fmt.Sprintf("e.id = $%d", len(e.args)+1))
 		e.args = append(e.args, entryID)
+		e.withDuplicates = true
+	}
+	return e
+}
+
+// WithDuplicatesOf adds a condition to fetch the other entries of the duplicate group of the given entry.
+func (e *EntryQueryBuilder) WithDuplicatesOf(entry *model.Entry) *EntryQueryBuilder {
+	originalID := entry.ID
+	if entry.DuplicateOf != 0 {
+		originalID = entry.DuplicateOf
 	}
+
+	e.conditions = append(e.conditions, fmt.Sprintf("(e.id = $%d OR e.duplicate_of = $%d) AND e.id <> $%d", len(e.args)+1, len(e.args)+1, len(e.args)+2))
+	e.args = append(e.args, originalID, entry.ID)
+	e.withDuplicates = true
 	return e
 }
 
@@ -111,6 +130,38 @@ func (e *EntryQueryBuilder) WithCategoryID(categoryID int64) *EntryQueryBuilder
 	return e
 }
 
+// WithTagID adds a condition to fetch only the entries having the given tag.
+func (e *EntryQueryBuilder) WithTagID(tagID int64) *EntryQueryBuilder {
+	if tagID != 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.id IN (SELECT entry_id FROM entry_tags WHERE tag_id = $%d)", len(e.args)+1))
+		e.args = append(e.args, tagID)
+	}
+	return e
+}
+
+// WithTag adds a condition to fetch only the entries having a tag with the given title.
+func (e *EntryQueryBuilder) WithTag(title string) *EntryQueryBuilder {
+	if title != "" {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.id IN (SELECT et.entry_id FROM entry_tags et JOIN tags t ON t.id=et.tag_id WHERE t.user_id = $1 AND t.title = $%d)", len(e.args)+1))
+		e.args = append(e.args, title)
+	}
+	return e
+}
+
+// WithMinReadingTime filters the entries that take at least the given number of minutes to read.
+func (e *EntryQueryBuilder) WithMinReadingTime(minutes int) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.reading_time >= $%d", len(e.args)+1))
+	e.args = append(e.args, minutes)
+	return e
+}
+
+// WithMaxReadingTime filters the entries that take at most the given number of minutes to read.
+func (e *EntryQueryBuilder) WithMaxReadingTime(minutes int) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.reading_time <= $%d", len(e.args)+1))
+	e.args = append(e.args, minutes)
+	return e
+}
+
 // WithStatus set the entry status.
 func (e *EntryQueryBuilder) WithStatus(status string) *EntryQueryBuilder {
 	if status != "" {
@@ -185,6 +236,11 @@ func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 		return nil, err
 	}
 
+	entries[0].Duplicates, err = e.store.EntryDuplicates(entries[0].UserID, entries[0])
+	if err != nil {
+		return nil, err
+	}
+
 	return entries[0], nil
 }
 
@@ -192,12 +248,17 @@ func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 	query := `
 		SELECT
-		e.id, e.user_id, e.feed_id, e.hash, e.published_at at time zone u.timezone, e.title,
-		e.url, e.comments_url, e.author, e.content, e.status, e.starred,
+		e.id, e.user_id, e.feed_id, e.hash, e.published_at at time zone u.timezone,
+		e.updated_at at time zone u.timezone, e.title,
+		e.url, e.comments_url, e.author, e.content, e.reading_time, e.thumbnail_url,
+		e.subtitle, e.episode, e.season, e.explicit, e.status, e.starred, coalesce(e.duplicate_of, 0),
 		f.title as feed_title, f.feed_url, f.site_url, f.checked_at,
 		f.category_id, c.title as category_title, f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
+		f.proxy_url,
 		fi.icon_id,
-		u.timezone
+		u.timezone,
+		array(SELECT t.id FROM entry_tags et JOIN tags t ON t.id=et.tag_id WHERE et.entry_id=e.id ORDER BY lower(t.title)) as tag_ids,
+		array(SELECT t.title FROM entry_tags et JOIN tags t ON t.id=et.tag_id WHERE et.entry_id=e.id ORDER BY lower(t.title)) as tag_titles
 		FROM entries e
 		LEFT JOIN feeds f ON f.id=e.feed_id
 		LEFT JOIN categories c ON c.id=f.category_id
@@ -223,6 +284,8 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		var entry model.Entry
 		var iconID interface{}
 		var tz string
+		var tagIDs []int64
+		var tagTitles []string
 
 		entry.Feed = &model.Feed{}
 		entry.Feed.Category = &model.Category{}
@@ -234,13 +297,21 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.FeedID,
 			&entry.Hash,
 			&entry.Date,
+			&entry.UpdatedAt,
 			&entry.Title,
 			&entry.URL,
 			&entry.CommentsURL,
 			&entry.Author,
 			&entry.Content,
+			&entry.ReadingTime,
+			&entry.ThumbnailURL,
+			&entry.Subtitle,
+			&entry.Episode,
+			&entry.Season,
+			&entry.Explicit,
 			&entry.Status,
 			&entry.Starred,
+			&entry.DuplicateOf,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
 			&entry.Feed.SiteURL,
@@ -251,8 +322,11 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Feed.RewriteRules,
 			&entry.Feed.Crawler,
 			&entry.Feed.UserAgent,
+			&entry.Feed.ProxyURL,
 			&iconID,
 			&tz,
+			pq.Array(&tagIDs),
+			pq.Array(&tagTitles),
 		)
 
 		if err != nil {
@@ -267,12 +341,21 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 
 		// Make sure that timestamp fields contains timezone information (API)
 		entry.Date = timezone.Convert(tz, entry.Date)
+		if entry.UpdatedAt != nil {
+			*entry.UpdatedAt = timezone.Convert(tz, *entry.UpdatedAt)
+		}
 		entry.Feed.CheckedAt = timezone.Convert(tz, entry.Feed.CheckedAt)
 
 		entry.Feed.ID = entry.FeedID
 		entry.Feed.UserID = entry.UserID
 		entry.Feed.Icon.FeedID = entry.FeedID
 		entry.Feed.Category.UserID = entry.UserID
+
+		entry.Tags = make(model.Tags, 0, len(tagIDs))
+		for i, tagID := range tagIDs {
+			entry.Tags = append(entry.Tags, &model.Tag{ID: tagID, UserID: entry.UserID, Title: tagTitles[i]})
+		}
+
 		entries = append(entries, &entry)
 	}
 
@@ -311,6 +394,9 @@ func (e *EntryQueryBuilder) GetEntryIDs() ([]int64, error) {
 }
 
 func (e *EntryQueryBuilder) buildCondition() string {
+	if !e.withDuplicates {
+		return strings.Join(append(e.conditions, groupedDuplicatesCondition), " AND ")
+	}
 	return strings.Join(e.conditions, " AND ")
 }
 
@@ -0,0 +1,80 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+	"miniflux.app/timezone"
+)
+
+// createEntryRevision saves the previous version of an entry and keeps only the most recent revisions.
+func (s *Storage) createEntryRevision(tx *sql.Tx, entryID int64, title, content string) error {
+	limit := config.Opts.EntryRevisionsLimit()
+	if limit <= 0 {
+		return nil
+	}
+
+	query := `INSERT INTO entry_revisions (entry_id, title, content) VALUES ($1, $2, $3)`
+	if _, err := tx.Exec(query, entryID, title, content); err != nil {
+		return fmt.Errorf("unable to create revision of entry #%d: %v", entryID, err)
+	}
+
+	query = `
+		DELETE FROM entry_revisions
+		WHERE entry_id=$1 AND id NOT IN (SELECT id FROM entry_revisions WHERE entry_id=$1 ORDER BY created_at DESC, id DESC LIMIT $2)
+	`
+	if _, err := tx.Exec(query, entryID, limit); err != nil {
+		return fmt.Errorf("unable to remove old revisions of entry #%d: %v", entryID, err)
+	}
+
+	return nil
+}
+
+// EntryRevisions returns the previous versions of an entry, the most recent first.
+func (s *Storage) EntryRevisions(userID, entryID int64) (model.EntryRevisions, error) {
+	query := `
+		SELECT
+		r.id, r.entry_id, r.title, r.content, r.created_at at time zone u.timezone,
+		u.timezone
+		FROM entry_revisions r
+		JOIN entries e ON e.id=r.entry_id
+		JOIN users u ON u.id=e.user_id
+		WHERE e.user_id=$1 AND r.entry_id=$2
+		ORDER BY r.created_at DESC, r.id DESC
+	`
+
+	rows, err := s.db.Query(query, userID, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch revisions of entry #%d: %v", entryID, err)
+	}
+	defer rows.Close()
+
+	revisions := make(model.EntryRevisions, 0)
+	for rows.Next() {
+		var revision model.EntryRevision
+		var tz string
+
+		err := rows.Scan(
+			&revision.ID,
+			&revision.EntryID,
+			&revision.Title,
+			&revision.Content,
+			&revision.CreatedAt,
+			&tz,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch revision row: %v", err)
+		}
+
+		revision.CreatedAt = timezone.Convert(tz, revision.CreatedAt)
+		revisions = append(revisions, &revision)
+	}
+
+	return revisions, nil
+}
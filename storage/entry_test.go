@@ -0,0 +1,105 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/database"
+	"miniflux.app/model"
+)
+
+func newTestStorage(t *testing.T) *Storage {
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		t.Skip("DATABASE_URL is not defined")
+	}
+
+	db, err := database.NewConnectionPool(dsn, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewStorage(db)
+}
+
+func createTestFeed(t *testing.T, store *Storage, userID int64, siteURL string) *model.Feed {
+	category, err := store.FirstCategory(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed := &model.Feed{
+		UserID:   userID,
+		FeedURL:  siteURL + "feed.xml",
+		SiteURL:  siteURL,
+		Title:    siteURL,
+		Category: category,
+	}
+
+	if err := store.CreateFeed(feed); err != nil {
+		t.Fatal(err)
+	}
+
+	return feed
+}
+
+func TestDuplicatesWithSameGUIDInDifferentFeeds(t *testing.T) {
+	store := newTestStorage(t)
+
+	user := &model.User{Username: fmt.Sprintf("duplicates%d", time.Now().UnixNano()), Password: "test123"}
+	if err := store.CreateUser(user); err != nil {
+		t.Fatal(err)
+	}
+	defer store.RemoveUser(user.ID)
+
+	user.Password = ""
+	user.DuplicatePolicy = model.DuplicatePolicyGroup
+	if err := store.UpdateUser(user); err != nil {
+		t.Fatal(err)
+	}
+
+	firstFeed := createTestFeed(t, store, user.ID, "https://blog-a.example.org/")
+	secondFeed := createTestFeed(t, store, user.ID, "https://blog-b.example.org/")
+
+	// Both feeds use the same numeric GUID for unrelated stories.
+	entries := []struct {
+		feed  *model.Feed
+		title string
+	}{
+		{firstFeed, "Hello from blog A"},
+		{secondFeed, "Hello from blog B"},
+	}
+
+	for _, item := range entries {
+		entry := &model.Entry{
+			Hash:    crypto.Hash("1"),
+			Title:   item.title,
+			URL:     item.feed.SiteURL + "posts/1",
+			Date:    time.Now(),
+			Content: item.title,
+			Status:  model.EntryStatusUnread,
+		}
+
+		created, _, err := store.AppendEntries(user.ID, item.feed.ID, model.Entries{entry}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(created) != 1 {
+			t.Fatalf(`The entry %q should be created`, item.title)
+		}
+
+		if created[0].DuplicateOf != 0 || created[0].Grouped {
+			t.Errorf(`The entry %q should not be a duplicate of entry #%d`, item.title, created[0].DuplicateOf)
+		}
+	}
+}
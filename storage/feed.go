@@ -6,13 +6,21 @@ package storage // import "miniflux.app/storage"
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/timezone"
 )
 
+// maxParsingError is the number of consecutive errors after which a feed is reported as failing.
+const maxParsingError = 3
+
 // FeedExists checks if the given feed exists.
 func (s *Storage) FeedExists(userID, feedID int64) bool {
 	var result int
@@ -56,10 +64,11 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 	feeds := make(model.Feeds, 0)
 	query := `SELECT
 		f.id, f.feed_url, f.site_url, f.title, f.etag_header, f.last_modified_header,
-		f.user_id, f.checked_at at time zone u.timezone,
-		f.parsing_error_count, f.parsing_error_msg,
-		f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
-		f.username, f.password,
+		f.user_id, f.checked_at at time zone u.timezone, f.next_check_at at time zone u.timezone,
+		f.parsing_error_count, f.parsing_error_msg, f.ttl,
+		f.hub_url, f.hub_topic_url, f.hub_secret, f.hub_lease_expires_at at time zone u.timezone,
+		f.scraper_rules, f.rewrite_rules, f.keep_rules, f.block_rules, f.filter_action, f.crawler, f.mark_unread_on_update, f.keep_tracking_parameters, f.resolve_final_url, f.disabled, f.user_agent,
+		f.username, f.password, f.proxy_url, f.headers, f.cookie,
 		f.category_id, c.title as category_title,
 		fi.icon_id,
 		u.timezone
@@ -80,6 +89,7 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 		var feed model.Feed
 		var iconID interface{}
 		var tz string
+		var headers, cookie string
 		feed.Category = &model.Category{UserID: userID}
 
 		err := rows.Scan(
@@ -91,14 +101,30 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 			&feed.LastModifiedHeader,
 			&feed.UserID,
 			&feed.CheckedAt,
+			&feed.NextCheckAt,
 			&feed.ParsingErrorCount,
 			&feed.ParsingErrorMsg,
+			&feed.TTL,
+			&feed.HubURL,
+			&feed.HubTopicURL,
+			&feed.HubSecret,
+			&feed.HubLeaseExpiresAt,
 			&feed.ScraperRules,
 			&feed.RewriteRules,
+			&feed.KeepRules,
+			&feed.BlockRules,
+			&feed.FilterAction,
 			&feed.Crawler,
+			&feed.MarkUnreadOnUpdate,
+			&feed.KeepTrackingParameters,
+			&feed.ResolveFinalURL,
+			&feed.Disabled,
 			&feed.UserAgent,
 			&feed.Username,
 			&feed.Password,
+			&feed.ProxyURL,
+			&headers,
+			&cookie,
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&iconID,
@@ -114,6 +140,9 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 		}
 
 		feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
+		feed.NextCheckAt = timezone.Convert(tz, feed.NextCheckAt)
+		feed.HubLeaseExpiresAt = timezone.Convert(tz, feed.HubLeaseExpiresAt)
+		decryptRequestHeaders(&feed, headers, cookie)
 		feeds = append(feeds, &feed)
 	}
 
@@ -125,15 +154,17 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 	var feed model.Feed
 	var iconID interface{}
 	var tz string
+	var headers, cookie string
 	feed.Category = &model.Category{UserID: userID}
 
 	query := `
 		SELECT
 		f.id, f.feed_url, f.site_url, f.title, f.etag_header, f.last_modified_header,
-		f.user_id, f.checked_at at time zone u.timezone,
-		f.parsing_error_count, f.parsing_error_msg,
-		f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
-		f.username, f.password,
+		f.user_id, f.checked_at at time zone u.timezone, f.next_check_at at time zone u.timezone,
+		f.parsing_error_count, f.parsing_error_msg, f.ttl,
+		f.hub_url, f.hub_topic_url, f.hub_secret, f.hub_lease_expires_at at time zone u.timezone,
+		f.scraper_rules, f.rewrite_rules, f.keep_rules, f.block_rules, f.filter_action, f.crawler, f.mark_unread_on_update, f.keep_tracking_parameters, f.resolve_final_url, f.disabled, f.user_agent,
+		f.username, f.password, f.proxy_url, f.headers, f.cookie,
 		f.category_id, c.title as category_title,
 		fi.icon_id,
 		u.timezone
@@ -152,14 +183,30 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		&feed.LastModifiedHeader,
 		&feed.UserID,
 		&feed.CheckedAt,
+		&feed.NextCheckAt,
 		&feed.ParsingErrorCount,
 		&feed.ParsingErrorMsg,
+		&feed.TTL,
+		&feed.HubURL,
+		&feed.HubTopicURL,
+		&feed.HubSecret,
+		&feed.HubLeaseExpiresAt,
 		&feed.ScraperRules,
 		&feed.RewriteRules,
+		&feed.KeepRules,
+		&feed.BlockRules,
+		&feed.FilterAction,
 		&feed.Crawler,
+		&feed.MarkUnreadOnUpdate,
+		&feed.KeepTrackingParameters,
+		&feed.ResolveFinalURL,
+		&feed.Disabled,
 		&feed.UserAgent,
 		&feed.Username,
 		&feed.Password,
+		&feed.ProxyURL,
+		&headers,
+		&cookie,
 		&feed.Category.ID,
 		&feed.Category.Title,
 		&iconID,
@@ -178,19 +225,27 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 	}
 
 	feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
+	feed.NextCheckAt = timezone.Convert(tz, feed.NextCheckAt)
+	feed.HubLeaseExpiresAt = timezone.Convert(tz, feed.HubLeaseExpiresAt)
+	decryptRequestHeaders(&feed, headers, cookie)
 	return &feed, nil
 }
 
 // CreateFeed creates a new feed.
 func (s *Storage) CreateFeed(feed *model.Feed) error {
+	headers, cookie, err := encryptRequestHeaders(feed)
+	if err != nil {
+		return fmt.Errorf("unable to create feed %q: %v", feed.FeedURL, err)
+	}
+
 	sql := `
 		INSERT INTO feeds
-		(feed_url, site_url, title, category_id, user_id, etag_header, last_modified_header, crawler, user_agent, username, password)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		(feed_url, site_url, title, category_id, user_id, etag_header, last_modified_header, crawler, user_agent, username, password, next_check_at, ttl, hub_url, hub_topic_url, proxy_url, headers, cookie)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		RETURNING id
 	`
 
-	err := s.db.QueryRow(
+	err = s.db.QueryRow(
 		sql,
 		feed.FeedURL,
 		feed.SiteURL,
@@ -203,6 +258,13 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.UserAgent,
 		feed.Username,
 		feed.Password,
+		feed.NextCheckAt,
+		feed.TTL,
+		feed.HubURL,
+		feed.HubTopicURL,
+		feed.ProxyURL,
+		headers,
+		cookie,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf("unable to create feed %q: %v", feed.FeedURL, err)
@@ -211,7 +273,7 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 	for i := 0; i < len(feed.Entries); i++ {
 		feed.Entries[i].FeedID = feed.ID
 		feed.Entries[i].UserID = feed.UserID
-		err := s.createEntry(feed.Entries[i])
+		err := s.createEntry(feed.Entries[i], entryCanonicalURL(feed.Entries[i].URL, feed.FeedURL, feed.SiteURL))
 		if err != nil {
 			return err
 		}
@@ -222,11 +284,19 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 
 // UpdateFeed updates an existing feed.
 func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
+	headers, cookie, err := encryptRequestHeaders(feed)
+	if err != nil {
+		return fmt.Errorf("unable to update feed #%d (%s): %v", feed.ID, feed.FeedURL, err)
+	}
+
 	query := `UPDATE feeds SET
 		feed_url=$1, site_url=$2, title=$3, category_id=$4, etag_header=$5, last_modified_header=$6, checked_at=$7,
 		parsing_error_msg=$8, parsing_error_count=$9, scraper_rules=$10, rewrite_rules=$11, crawler=$12, user_agent=$13,
-		username=$14, password=$15
-		WHERE id=$16 AND user_id=$17`
+		username=$14, password=$15, next_check_at=$16, ttl=$17, disabled=$18, hub_url=$19, hub_topic_url=$20,
+		proxy_url=$21, headers=$22, cookie=$23, mark_unread_on_update=$24,
+		keep_rules=$25, block_rules=$26, filter_action=$27, keep_tracking_parameters=$28,
+		resolve_final_url=$29
+		WHERE id=$30 AND user_id=$31`
 
 	_, err = s.db.Exec(query,
 		feed.FeedURL,
@@ -244,6 +314,20 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.UserAgent,
 		feed.Username,
 		feed.Password,
+		feed.NextCheckAt,
+		feed.TTL,
+		feed.Disabled,
+		feed.HubURL,
+		feed.HubTopicURL,
+		feed.ProxyURL,
+		headers,
+		cookie,
+		feed.MarkUnreadOnUpdate,
+		feed.KeepRules,
+		feed.BlockRules,
+		feed.FilterAction,
+		feed.KeepTrackingParameters,
+		feed.ResolveFinalURL,
 		feed.ID,
 		feed.UserID,
 	)
@@ -262,13 +346,17 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 		SET
 			parsing_error_msg=$1,
 			parsing_error_count=$2,
-			checked_at=$3
-		WHERE id=$4 AND user_id=$5`
+			checked_at=$3,
+			next_check_at=$4,
+			disabled=$5
+		WHERE id=$6 AND user_id=$7`
 
 	_, err = s.db.Exec(query,
 		feed.ParsingErrorMsg,
 		feed.ParsingErrorCount,
 		feed.CheckedAt,
+		feed.NextCheckAt,
+		feed.Disabled,
 		feed.ID,
 		feed.UserID,
 	)
@@ -280,6 +368,83 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 	return nil
 }
 
+// UpdateFeedHubSecret stores the secret shared with the WebSub hub.
+func (s *Storage) UpdateFeedHubSecret(feed *model.Feed) error {
+	query := `UPDATE feeds SET hub_secret=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, feed.HubSecret, feed.ID, feed.UserID); err != nil {
+		return fmt.Errorf("unable to update hub secret of feed #%d: %v", feed.ID, err)
+	}
+
+	return nil
+}
+
+// UpdateFeedHubPendingMode remembers the request sent to the WebSub hub, until the hub verifies it or the given date.
+func (s *Storage) UpdateFeedHubPendingMode(feed *model.Feed, mode string, expiresAt time.Time) error {
+	query := `UPDATE feeds SET hub_pending_mode=$1, hub_pending_expires_at=$2 WHERE id=$3 AND user_id=$4`
+	if _, err := s.db.Exec(query, mode, expiresAt, feed.ID, feed.UserID); err != nil {
+		return fmt.Errorf("unable to update pending hub request of feed #%d: %v", feed.ID, err)
+	}
+
+	return nil
+}
+
+// FeedHubPendingMode returns the request waiting for the verification of the WebSub hub, or an empty string.
+func (s *Storage) FeedHubPendingMode(feed *model.Feed) (string, error) {
+	var mode string
+	query := `SELECT hub_pending_mode FROM feeds WHERE id=$1 AND user_id=$2 AND hub_pending_expires_at > now()`
+	err := s.db.QueryRow(query, feed.ID, feed.UserID).Scan(&mode)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("unable to fetch pending hub request of feed #%d: %v", feed.ID, err)
+	}
+
+	return mode, nil
+}
+
+// UpdateFeedHubLease stores the expiration date of the WebSub subscription, the pending request is verified.
+func (s *Storage) UpdateFeedHubLease(feed *model.Feed) error {
+	query := `UPDATE feeds SET hub_lease_expires_at=$1, hub_pending_mode='' WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, feed.HubLeaseExpiresAt, feed.ID, feed.UserID); err != nil {
+		return fmt.Errorf("unable to update hub lease of feed #%d: %v", feed.ID, err)
+	}
+
+	return nil
+}
+
+// FeedUserID returns the ID of the user who owns the given feed, or 0 if the feed doesn't exist.
+func (s *Storage) FeedUserID(feedID int64) (int64, error) {
+	var userID int64
+	err := s.db.QueryRow(`SELECT user_id FROM feeds WHERE id=$1`, feedID).Scan(&userID)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("unable to fetch owner of feed #%d: %v", feedID, err)
+	}
+
+	return userID, nil
+}
+
+// WeeklyFeedEntryCount returns the number of entries published during the last week for the given feed.
+func (s *Storage) WeeklyFeedEntryCount(userID, feedID int64) (int, error) {
+	query := `
+		SELECT count(*)
+		FROM entries
+		WHERE user_id=$1 AND feed_id=$2 AND published_at BETWEEN (now() - interval '1 week') AND now()`
+
+	var weeklyCount int
+	err := s.db.QueryRow(query, userID, feedID).Scan(&weeklyCount)
+	if err != nil {
+		return 0, fmt.Errorf("unable to count weekly entries of feed #%d: %v", feedID, err)
+	}
+
+	return weeklyCount, nil
+}
+
 // RemoveFeed removes a feed.
 func (s *Storage) RemoveFeed(userID, feedID int64) error {
 	result, err := s.db.Exec("DELETE FROM feeds WHERE id = $1 AND user_id = $2", feedID, userID)
@@ -301,6 +466,63 @@ func (s *Storage) RemoveFeed(userID, feedID int64) error {
 
 // ResetFeedErrors removes all feed errors.
 func (s *Storage) ResetFeedErrors() error {
-	_, err := s.db.Exec(`UPDATE feeds SET parsing_error_count=0, parsing_error_msg=''`)
+	_, err := s.db.Exec(`UPDATE feeds SET parsing_error_count=0, parsing_error_msg='', next_check_at=now()`)
 	return err
 }
+
+// encryptRequestHeaders returns the custom headers and the cookie of the feed encrypted for storage.
+// The stored values that could not be decrypted are kept until the headers or the cookie are modified.
+func encryptRequestHeaders(feed *model.Feed) (headers, cookie string, err error) {
+	headers, cookie = feed.EncryptedHeaders, feed.EncryptedCookie
+
+	if (len(feed.Headers) > 0 || feed.Cookie != "") && !config.Opts.HasEncryptionKey() {
+		return "", "", errors.New("custom headers and cookies require an encryption key (ENCRYPTION_KEY)")
+	}
+
+	if len(feed.Headers) > 0 {
+		data, err := json.Marshal(feed.Headers)
+		if err != nil {
+			return "", "", fmt.Errorf("unable to encode request headers: %v", err)
+		}
+
+		if headers, err = crypto.Encrypt(config.Opts.EncryptionKey(), string(data)); err != nil {
+			return "", "", fmt.Errorf("unable to encrypt request headers: %v", err)
+		}
+	}
+
+	if feed.Cookie != "" {
+		if cookie, err = crypto.Encrypt(config.Opts.EncryptionKey(), feed.Cookie); err != nil {
+			return "", "", fmt.Errorf("unable to encrypt cookie: %v", err)
+		}
+	}
+
+	return headers, cookie, nil
+}
+
+// decryptRequestHeaders sets the custom headers and the cookie of the feed from their stored values.
+// Values that cannot be decrypted, for example after a change of the encryption key, are not sent
+// but their stored value is kept, so they are not lost when the feed is saved again.
+func decryptRequestHeaders(feed *model.Feed, headers, cookie string) {
+	if headers != "" {
+		data, err := crypto.Decrypt(config.Opts.EncryptionKey(), headers)
+		if err == nil {
+			err = json.Unmarshal([]byte(data), &feed.Headers)
+		}
+
+		if err != nil {
+			logger.Error("[Storage:DecryptRequestHeaders] feedID=%d: %v", feed.ID, err)
+			feed.Headers = nil
+			feed.EncryptedHeaders = headers
+		}
+	}
+
+	if cookie != "" {
+		data, err := crypto.Decrypt(config.Opts.EncryptionKey(), cookie)
+		if err != nil {
+			logger.Error("[Storage:DecryptRequestHeaders] feedID=%d: %v", feed.ID, err)
+			feed.EncryptedCookie = cookie
+		} else {
+			feed.Cookie = data
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+	"miniflux.app/timezone"
+)
+
+// CreateFeedFetch records a feed refresh and keeps only the most recent records of the feed.
+func (s *Storage) CreateFeedFetch(fetch *model.FeedFetch, limit int) error {
+	query := `
+		INSERT INTO feed_fetch_log
+		(feed_id, fetched_at, status_code, duration, size, not_modified, created_entries, updated_entries, error_msg, previous_url, new_url)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id
+	`
+	err := s.db.QueryRow(
+		query,
+		fetch.FeedID,
+		fetch.FetchedAt,
+		fetch.StatusCode,
+		fetch.Duration,
+		fetch.Size,
+		fetch.NotModified,
+		fetch.CreatedEntries,
+		fetch.UpdatedEntries,
+		fetch.Error,
+		fetch.PreviousURL,
+		fetch.NewURL,
+	).Scan(&fetch.ID)
+
+	if err != nil {
+		return fmt.Errorf("unable to record fetch of feed #%d: %v", fetch.FeedID, err)
+	}
+
+	query = `
+		DELETE FROM feed_fetch_log
+		WHERE feed_id=$1 AND id NOT IN (SELECT id FROM feed_fetch_log WHERE feed_id=$1 ORDER BY fetched_at DESC LIMIT $2)
+	`
+	if _, err := s.db.Exec(query, fetch.FeedID, limit); err != nil {
+		return fmt.Errorf("unable to remove old fetches of feed #%d: %v", fetch.FeedID, err)
+	}
+
+	return nil
+}
+
+// FeedFetches returns the refresh history of a feed, the most recent first.
+func (s *Storage) FeedFetches(userID, feedID int64) (model.FeedFetches, error) {
+	query := `
+		SELECT
+		l.id, l.feed_id, l.fetched_at at time zone u.timezone, l.status_code, l.duration, l.size,
+		l.not_modified, l.created_entries, l.updated_entries, l.error_msg,
+		l.previous_url, l.new_url,
+		u.timezone
+		FROM feed_fetch_log l
+		JOIN feeds f ON f.id=l.feed_id
+		JOIN users u ON u.id=f.user_id
+		WHERE f.user_id=$1 AND l.feed_id=$2
+		ORDER BY l.fetched_at DESC
+	`
+	rows, err := s.db.Query(query, userID, feedID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch history of feed #%d: %v", feedID, err)
+	}
+	defer rows.Close()
+
+	fetches := make(model.FeedFetches, 0)
+	for rows.Next() {
+		var fetch model.FeedFetch
+		var tz string
+
+		err := rows.Scan(
+			&fetch.ID,
+			&fetch.FeedID,
+			&fetch.FetchedAt,
+			&fetch.StatusCode,
+			&fetch.Duration,
+			&fetch.Size,
+			&fetch.NotModified,
+			&fetch.CreatedEntries,
+			&fetch.UpdatedEntries,
+			&fetch.Error,
+			&fetch.PreviousURL,
+			&fetch.NewURL,
+			&tz,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch feed history row: %v", err)
+		}
+
+		fetch.FetchedAt = timezone.Convert(tz, fetch.FetchedAt)
+		fetches = append(fetches, &fetch)
+	}
+
+	return fetches, nil
+}
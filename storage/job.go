@@ -10,18 +10,27 @@ import (
 	"miniflux.app/model"
 )
 
-const maxParsingError = 3
-
-// NewBatch returns a serie of jobs.
+// NewBatch returns a serie of jobs for the feeds that are due for a refresh.
+//
+// Disabled feeds are skipped. Failing feeds are not excluded,
+// their next check is delayed with an exponential backoff instead.
+//
+// The batch size is the number of distinct fetches, as grouped by the worker pool:
+// all the due subscriptions sharing the same request are returned together.
 func (s *Storage) NewBatch(batchSize int) (jobs model.JobList, err error) {
 	query := `
 		SELECT
-		id, user_id
+		id, user_id, feed_url, username, password, user_agent, proxy_url, headers, cookie
 		FROM feeds
-		WHERE parsing_error_count < $1
-		ORDER BY checked_at ASC LIMIT %d`
+		WHERE disabled='f' AND next_check_at <= now()
+		ORDER BY next_check_at ASC`
+
+	jobs, err = s.fetchBatchRows(query)
+	if err != nil {
+		return nil, err
+	}
 
-	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), maxParsingError)
+	return jobs.LimitFetches(batchSize), nil
 }
 
 // NewUserBatch returns a serie of jobs but only for a given user.
@@ -30,9 +39,9 @@ func (s *Storage) NewUserBatch(userID int64, batchSize int) (jobs model.JobList,
 	// user refresh manually all his feeds to force a refresh.
 	query := `
 		SELECT
-		id, user_id
+		id, user_id, feed_url, username, password, user_agent, proxy_url, headers, cookie
 		FROM feeds
-		WHERE user_id=$1
+		WHERE user_id=$1 AND disabled='f'
 		ORDER BY checked_at ASC LIMIT %d`
 
 	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), userID)
@@ -47,7 +56,7 @@ func (s *Storage) fetchBatchRows(query string, args ...interface{}) (jobs model.
 
 	for rows.Next() {
 		var job model.Job
-		if err := rows.Scan(&job.FeedID, &job.UserID); err != nil {
+		if err := rows.Scan(&job.FeedID, &job.UserID, &job.FeedURL, &job.Username, &job.Password, &job.UserAgent, &job.ProxyURL, &job.Headers, &job.Cookie); err != nil {
 			return nil, fmt.Errorf("unable to fetch job: %v", err)
 		}
 
@@ -0,0 +1,62 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// ResolvedURLs returns the final destination of the given URLs when they are already known for this user.
+func (s *Storage) ResolvedURLs(userID int64, urls []string) (map[string]string, error) {
+	resolvedURLs := make(map[string]string)
+	if len(urls) == 0 {
+		return resolvedURLs, nil
+	}
+
+	query := `SELECT url, final_url FROM resolved_urls WHERE user_id=$1 AND url=ANY($2)`
+	rows, err := s.db.Query(query, userID, pq.Array(urls))
+	if err != nil {
+		return nil, fmt.Errorf(`unable to fetch resolved URLs: %v`, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var url, finalURL string
+		if err := rows.Scan(&url, &finalURL); err != nil {
+			return nil, fmt.Errorf(`unable to fetch resolved URL: %v`, err)
+		}
+		resolvedURLs[url] = finalURL
+	}
+
+	return resolvedURLs, nil
+}
+
+// SaveResolvedURL stores the final destination of a URL for this user.
+func (s *Storage) SaveResolvedURL(userID int64, url, finalURL string) error {
+	query := `
+		INSERT INTO resolved_urls (user_id, url, final_url) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, url) DO UPDATE SET final_url=EXCLUDED.final_url, created_at=now()
+	`
+	if _, err := s.db.Exec(query, userID, url, finalURL); err != nil {
+		return fmt.Errorf(`unable to save resolved URL %q: %v`, url, err)
+	}
+
+	return nil
+}
+
+// CleanOldResolvedURLs removes the resolved URLs older than 30 days, they are resolved again when still used.
+func (s *Storage) CleanOldResolvedURLs() int64 {
+	query := `DELETE FROM resolved_urls WHERE created_at < now() - interval '30 days'`
+
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -0,0 +1,179 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+const ruleColumns = `id, user_id, title, coalesce(feed_id, 0), coalesce(category_id, 0),
+	title_pattern, content_pattern, author_pattern, older_than,
+	mark_as_read, star, send_to_integrations, tag, match_count`
+
+// Rules returns all the rules of the given user.
+func (s *Storage) Rules(userID int64) (model.Rules, error) {
+	query := `SELECT ` + ruleColumns + ` FROM rules WHERE user_id=$1 ORDER BY id ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch rules: %v", err)
+	}
+	defer rows.Close()
+
+	rules := make(model.Rules, 0)
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch rules row: %v", err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// RuleByID returns a rule of the given user.
+func (s *Storage) RuleByID(userID, ruleID int64) (*model.Rule, error) {
+	query := `SELECT ` + ruleColumns + ` FROM rules WHERE user_id=$1 AND id=$2`
+	rule, err := scanRule(s.db.QueryRow(query, userID, ruleID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to fetch rule #%d: %v", ruleID, err)
+	}
+
+	return rule, nil
+}
+
+// CreateRule creates a new rule.
+func (s *Storage) CreateRule(rule *model.Rule) error {
+	query := `
+		INSERT INTO rules
+		(user_id, title, feed_id, category_id, title_pattern, content_pattern, author_pattern, older_than,
+		mark_as_read, star, send_to_integrations, tag)
+		VALUES
+		($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id
+	`
+	err := s.db.QueryRow(
+		query,
+		rule.UserID,
+		rule.Title,
+		nullableID(rule.FeedID),
+		nullableID(rule.CategoryID),
+		rule.TitlePattern,
+		rule.ContentPattern,
+		rule.AuthorPattern,
+		rule.OlderThan,
+		rule.MarkAsRead,
+		rule.Star,
+		rule.SendToIntegrations,
+		rule.Tag,
+	).Scan(&rule.ID)
+
+	if err != nil {
+		return fmt.Errorf("unable to create rule: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateRule updates an existing rule.
+func (s *Storage) UpdateRule(rule *model.Rule) error {
+	query := `
+		UPDATE rules SET
+			title=$1, feed_id=$2, category_id=$3, title_pattern=$4, content_pattern=$5, author_pattern=$6,
+			older_than=$7, mark_as_read=$8, star=$9, send_to_integrations=$10, tag=$11
+		WHERE id=$12 AND user_id=$13
+	`
+	_, err := s.db.Exec(
+		query,
+		rule.Title,
+		nullableID(rule.FeedID),
+		nullableID(rule.CategoryID),
+		rule.TitlePattern,
+		rule.ContentPattern,
+		rule.AuthorPattern,
+		rule.OlderThan,
+		rule.MarkAsRead,
+		rule.Star,
+		rule.SendToIntegrations,
+		rule.Tag,
+		rule.ID,
+		rule.UserID,
+	)
+
+	if err != nil {
+		return fmt.Errorf("unable to update rule #%d: %v", rule.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveRule deletes a rule.
+func (s *Storage) RemoveRule(userID, ruleID int64) error {
+	result, err := s.db.Exec(`DELETE FROM rules WHERE id=$1 AND user_id=$2`, ruleID, userID)
+	if err != nil {
+		return fmt.Errorf("unable to remove rule #%d: %v", ruleID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to remove rule #%d: %v", ruleID, err)
+	}
+
+	if count == 0 {
+		return fmt.Errorf("rule #%d not found", ruleID)
+	}
+
+	return nil
+}
+
+// IncrementRuleMatchCount adds the number of entries matched by the rule to its counter.
+func (s *Storage) IncrementRuleMatchCount(ruleID int64, count int) error {
+	query := `UPDATE rules SET match_count = match_count + $1 WHERE id=$2`
+	if _, err := s.db.Exec(query, count, ruleID); err != nil {
+		return fmt.Errorf("unable to update the match count of rule #%d: %v", ruleID, err)
+	}
+
+	return nil
+}
+
+type ruleScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRule(row ruleScanner) (*model.Rule, error) {
+	var rule model.Rule
+	err := row.Scan(
+		&rule.ID,
+		&rule.UserID,
+		&rule.Title,
+		&rule.FeedID,
+		&rule.CategoryID,
+		&rule.TitlePattern,
+		&rule.ContentPattern,
+		&rule.AuthorPattern,
+		&rule.OlderThan,
+		&rule.MarkAsRead,
+		&rule.Star,
+		&rule.SendToIntegrations,
+		&rule.Tag,
+		&rule.MatchCount,
+	)
+
+	return &rule, err
+}
+
+// nullableID returns nil for the optional foreign keys left empty.
+func nullableID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
@@ -0,0 +1,197 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+const siteRuleColumns = `id, coalesce(user_id, 0), user_id IS NULL, domain, scraper_rules, rewrite_rules, disabled`
+
+// SiteRules returns the global site rules and the site rules of the given user.
+func (s *Storage) SiteRules(userID int64) (model.SiteRules, error) {
+	query := `
+		SELECT ` + siteRuleColumns + `
+		FROM site_rules
+		WHERE user_id IS NULL OR user_id=$1
+		ORDER BY domain ASC, user_id ASC NULLS LAST
+	`
+	return s.fetchSiteRules(query, userID)
+}
+
+// GlobalSiteRules returns the site rules shared by all users.
+func (s *Storage) GlobalSiteRules() (model.SiteRules, error) {
+	query := `SELECT ` + siteRuleColumns + ` FROM site_rules WHERE user_id IS NULL ORDER BY domain ASC`
+	return s.fetchSiteRules(query)
+}
+
+// UserSiteRules returns the site rules of the given user only.
+func (s *Storage) UserSiteRules(userID int64) (model.SiteRules, error) {
+	query := `SELECT ` + siteRuleColumns + ` FROM site_rules WHERE user_id=$1 ORDER BY domain ASC`
+	return s.fetchSiteRules(query, userID)
+}
+
+func (s *Storage) fetchSiteRules(query string, args ...interface{}) (model.SiteRules, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch site rules: %v", err)
+	}
+	defer rows.Close()
+
+	rules := make(model.SiteRules, 0)
+	for rows.Next() {
+		rule, err := scanSiteRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch site rules row: %v", err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// SiteRuleByID returns a site rule, global or not.
+func (s *Storage) SiteRuleByID(ruleID int64) (*model.SiteRule, error) {
+	query := `SELECT ` + siteRuleColumns + ` FROM site_rules WHERE id=$1`
+	rule, err := scanSiteRule(s.db.QueryRow(query, ruleID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to fetch site rule #%d: %v", ruleID, err)
+	}
+
+	return rule, nil
+}
+
+// AnotherSiteRuleExists checks if another rule of the same owner is defined for the domain.
+func (s *Storage) AnotherSiteRuleExists(rule *model.SiteRule) bool {
+	var result bool
+	query := `
+		SELECT true FROM site_rules
+		WHERE id <> $1 AND domain=$2 AND ((user_id IS NULL AND $3) OR (user_id=$4 AND NOT $3))
+	`
+	s.db.QueryRow(query, rule.ID, rule.Domain, rule.Global, rule.UserID).Scan(&result)
+	return result
+}
+
+// CreateSiteRule creates a new site rule, the global rules have no user.
+func (s *Storage) CreateSiteRule(rule *model.SiteRule) error {
+	query := `
+		INSERT INTO site_rules
+		(user_id, domain, scraper_rules, rewrite_rules, disabled)
+		VALUES
+		($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	err := s.db.QueryRow(
+		query,
+		siteRuleOwner(rule),
+		rule.Domain,
+		rule.ScraperRules,
+		rule.RewriteRules,
+		rule.Disabled,
+	).Scan(&rule.ID)
+
+	if err != nil {
+		return fmt.Errorf("unable to create site rule: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateSiteRule updates an existing site rule.
+func (s *Storage) UpdateSiteRule(rule *model.SiteRule) error {
+	query := `UPDATE site_rules SET domain=$1, scraper_rules=$2, rewrite_rules=$3, disabled=$4 WHERE id=$5`
+	_, err := s.db.Exec(query, rule.Domain, rule.ScraperRules, rule.RewriteRules, rule.Disabled, rule.ID)
+	if err != nil {
+		return fmt.Errorf("unable to update site rule #%d: %v", rule.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveSiteRule deletes a site rule.
+func (s *Storage) RemoveSiteRule(ruleID int64) error {
+	result, err := s.db.Exec(`DELETE FROM site_rules WHERE id=$1`, ruleID)
+	if err != nil {
+		return fmt.Errorf("unable to remove site rule #%d: %v", ruleID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to remove site rule #%d: %v", ruleID, err)
+	}
+
+	if count == 0 {
+		return fmt.Errorf("site rule #%d not found", ruleID)
+	}
+
+	return nil
+}
+
+// ImportSiteRules creates the given rules, or replaces the existing rules of the same domain.
+// The rules are global when userID is 0.
+func (s *Storage) ImportSiteRules(userID int64, rules model.SiteRules) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %v", err)
+	}
+
+	owner := nullableID(userID)
+	for _, rule := range rules {
+		query := `
+			UPDATE site_rules SET scraper_rules=$1, rewrite_rules=$2, disabled=$3
+			WHERE domain=$4 AND user_id IS NOT DISTINCT FROM $5
+		`
+		result, err := tx.Exec(query, rule.ScraperRules, rule.RewriteRules, rule.Disabled, rule.Domain, owner)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("unable to import site rule %q: %v", rule.Domain, err)
+		}
+
+		if count, _ := result.RowsAffected(); count > 0 {
+			continue
+		}
+
+		query = `INSERT INTO site_rules (user_id, domain, scraper_rules, rewrite_rules, disabled) VALUES ($1, $2, $3, $4, $5)`
+		if _, err := tx.Exec(query, owner, rule.Domain, rule.ScraperRules, rule.RewriteRules, rule.Disabled); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("unable to import site rule %q: %v", rule.Domain, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to import site rules: %v", err)
+	}
+
+	return nil
+}
+
+// siteRuleOwner returns nil for the global rules.
+func siteRuleOwner(rule *model.SiteRule) interface{} {
+	if rule.Global {
+		return nil
+	}
+	return rule.UserID
+}
+
+func scanSiteRule(row ruleScanner) (*model.SiteRule, error) {
+	var rule model.SiteRule
+	err := row.Scan(
+		&rule.ID,
+		&rule.UserID,
+		&rule.Global,
+		&rule.Domain,
+		&rule.ScraperRules,
+		&rule.RewriteRules,
+		&rule.Disabled,
+	)
+
+	return &rule, err
+}
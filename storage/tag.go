@@ -0,0 +1,149 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/model"
+)
+
+// Tags returns the tags of the given user with the number of entries, the tags without entries are ignored.
+func (s *Storage) Tags(userID int64) (model.Tags, error) {
+	query := `
+		SELECT t.id, t.user_id, t.title, count(e.id)
+		FROM tags t
+		JOIN entry_tags et ON et.tag_id=t.id
+		JOIN entries e ON e.id=et.entry_id AND e.status <> $2 AND ` + groupedDuplicatesCondition + `
+		WHERE t.user_id=$1
+		GROUP BY t.id
+		ORDER BY lower(t.title) ASC
+	`
+	rows, err := s.db.Query(query, userID, model.EntryStatusRemoved)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch tags: %v", err)
+	}
+	defer rows.Close()
+
+	tags := make(model.Tags, 0)
+	for rows.Next() {
+		var tag model.Tag
+		if err := rows.Scan(&tag.ID, &tag.UserID, &tag.Title, &tag.EntryCount); err != nil {
+			return nil, fmt.Errorf("unable to fetch tags row: %v", err)
+		}
+
+		tags = append(tags, &tag)
+	}
+
+	return tags, nil
+}
+
+// Tag returns a tag of the given user.
+func (s *Storage) Tag(userID, tagID int64) (*model.Tag, error) {
+	var tag model.Tag
+
+	query := `SELECT id, user_id, title FROM tags WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, tagID).Scan(&tag.ID, &tag.UserID, &tag.Title)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to fetch tag #%d: %v", tagID, err)
+	}
+
+	return &tag, nil
+}
+
+// SetEntryTags replaces the tags of an entry, the tags not used anymore are removed.
+func (s *Storage) SetEntryTags(userID, entryID int64, titles []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %v", err)
+	}
+
+	// A nil slice would be sent as NULL and no tag would be removed.
+	if titles == nil {
+		titles = []string{}
+	}
+
+	query := `
+		DELETE FROM entry_tags
+		WHERE entry_id=$1 AND tag_id IN (SELECT id FROM tags WHERE user_id=$2 AND NOT (title=ANY($3)))
+	`
+	if _, err := tx.Exec(query, entryID, userID, pq.Array(titles)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to remove tags of entry #%d: %v", entryID, err)
+	}
+
+	if err := addEntryTags(tx, userID, entryID, titles); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query = `DELETE FROM tags WHERE user_id=$1 AND NOT EXISTS (SELECT 1 FROM entry_tags WHERE tag_id=tags.id)`
+	if _, err := tx.Exec(query, userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to remove unused tags: %v", err)
+	}
+
+	return tx.Commit()
+}
+
+// AddEntriesTag adds a tag to the given list of entries, the tag is created if necessary.
+func (s *Storage) AddEntriesTag(userID int64, entryIDs []int64, title string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %v", err)
+	}
+
+	var tagID int64
+	query := `
+		INSERT INTO tags (user_id, title) VALUES ($1, $2)
+		ON CONFLICT (user_id, title) DO UPDATE SET title=EXCLUDED.title
+		RETURNING id
+	`
+	if err := tx.QueryRow(query, userID, title).Scan(&tagID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to create tag %q: %v", title, err)
+	}
+
+	query = `
+		INSERT INTO entry_tags (entry_id, tag_id)
+		SELECT id, $1 FROM entries WHERE user_id=$2 AND id=ANY($3)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.Exec(query, tagID, userID, pq.Array(entryIDs)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to add tag %q to entries %v: %v", title, entryIDs, err)
+	}
+
+	return tx.Commit()
+}
+
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// addEntryTags attaches the given tags to an entry, the missing tags are created.
+func addEntryTags(db execer, userID, entryID int64, titles []string) error {
+	query := `
+		WITH tag AS (
+			INSERT INTO tags (user_id, title) VALUES ($1, $2)
+			ON CONFLICT (user_id, title) DO UPDATE SET title=EXCLUDED.title
+			RETURNING id
+		)
+		INSERT INTO entry_tags (entry_id, tag_id) SELECT $3, id FROM tag
+		ON CONFLICT DO NOTHING
+	`
+	for _, title := range titles {
+		if _, err := db.Exec(query, userID, title, entryID); err != nil {
+			return fmt.Errorf("unable to add tag %q to entry #%d: %v", title, entryID, err)
+		}
+	}
+
+	return nil
+}
@@ -65,7 +65,8 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		VALUES
 			(LOWER($1), $2, $3, $4)
 		RETURNING
-			id, username, is_admin, language, theme, timezone, entry_direction, keyboard_shortcuts
+			id, username, is_admin, language, theme, timezone, entry_direction, keyboard_shortcuts,
+			duplicate_policy, duplicate_match_title, mark_read_on_media_completion
 	`
 
 	err = s.db.QueryRow(query, user.Username, password, user.IsAdmin, extra).Scan(
@@ -77,6 +78,9 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		&user.Timezone,
 		&user.EntryDirection,
 		&user.KeyboardShortcuts,
+		&user.DuplicatePolicy,
+		&user.DuplicateMatchTitle,
+		&user.MarkReadOnMediaCompletion,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to create user: %v", err)
@@ -124,9 +128,12 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				language=$5,
 				timezone=$6,
 				entry_direction=$7,
-				keyboard_shortcuts=$8
+				keyboard_shortcuts=$8,
+				duplicate_policy=$9,
+				duplicate_match_title=$10,
+				mark_read_on_media_completion=$11
 			WHERE
-				id=$9
+				id=$12
 		`
 
 		_, err = s.db.Exec(
@@ -139,6 +146,9 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.Timezone,
 			user.EntryDirection,
 			user.KeyboardShortcuts,
+			user.DuplicatePolicy,
+			user.DuplicateMatchTitle,
+			user.MarkReadOnMediaCompletion,
 			user.ID,
 		)
 		if err != nil {
@@ -153,9 +163,12 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				language=$4,
 				timezone=$5,
 				entry_direction=$6,
-				keyboard_shortcuts=$7
+				keyboard_shortcuts=$7,
+				duplicate_policy=$8,
+				duplicate_match_title=$9,
+				mark_read_on_media_completion=$10
 			WHERE
-				id=$8
+				id=$11
 		`
 
 		_, err := s.db.Exec(
@@ -167,6 +180,9 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.Timezone,
 			user.EntryDirection,
 			user.KeyboardShortcuts,
+			user.DuplicatePolicy,
+			user.DuplicateMatchTitle,
+			user.MarkReadOnMediaCompletion,
 			user.ID,
 		)
 
@@ -193,6 +209,7 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 	query := `
 		SELECT
 			id, username, is_admin, theme, language, timezone, entry_direction, keyboard_shortcuts,
+			duplicate_policy, duplicate_match_title, mark_read_on_media_completion,
 			last_login_at, extra
 		FROM
 			users
@@ -208,6 +225,7 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 	query := `
 		SELECT
 			id, username, is_admin, theme, language, timezone, entry_direction, keyboard_shortcuts,
+			duplicate_policy, duplicate_match_title, mark_read_on_media_completion,
 			last_login_at, extra
 		FROM
 			users
@@ -223,6 +241,7 @@ func (s *Storage) UserByExtraField(field, value string) (*model.User, error) {
 	query := `
 		SELECT
 			id, username, is_admin, theme, language, timezone, entry_direction, keyboard_shortcuts,
+			duplicate_policy, duplicate_match_title, mark_read_on_media_completion,
 			last_login_at, extra
 		FROM
 			users
@@ -246,6 +265,9 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 		&user.Timezone,
 		&user.EntryDirection,
 		&user.KeyboardShortcuts,
+		&user.DuplicatePolicy,
+		&user.DuplicateMatchTitle,
+		&user.MarkReadOnMediaCompletion,
 		&user.LastLoginAt,
 		&extra,
 	)
@@ -289,6 +311,7 @@ func (s *Storage) Users() (model.Users, error) {
 	query := `
 		SELECT
 			id, username, is_admin, theme, language, timezone, entry_direction, keyboard_shortcuts,
+			duplicate_policy, duplicate_match_title, mark_read_on_media_completion,
 			last_login_at, extra
 		FROM
 			users
@@ -314,6 +337,9 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.Timezone,
 			&user.EntryDirection,
 			&user.KeyboardShortcuts,
+			&user.DuplicatePolicy,
+			&user.DuplicateMatchTitle,
+			&user.MarkReadOnMediaCompletion,
 			&user.LastLoginAt,
 			&extra,
 		)
@@ -31,6 +31,11 @@ var templateCommonMap = map[string]string{
         <li>
             <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed .user.Timezone .entry.Date }}</time>
         </li>
+        {{ if gt .entry.ReadingTime 0 }}
+            <li>
+                {{ plural "entry.estimated_reading_time" .entry.ReadingTime .entry.ReadingTime }}
+            </li>
+        {{ end }}
         {{ if .hasSaveEntry }}
             <li>
                 <a href="#"
@@ -145,6 +150,9 @@ var templateCommonMap = map[string]string{
                 <li {{ if eq .menu "categories" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g c" }}">
                     <a href="{{ route "categories" }}" data-page="categories">{{ t "menu.categories" }}</a>
                 </li>
+                <li {{ if eq .menu "tags" }}class="active"{{ end }}>
+                    <a href="{{ route "tags" }}" data-page="tags">{{ t "menu.tags" }}</a>
+                </li>
                 <li {{ if eq .menu "settings" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g s" }}">
                     <a href="{{ route "settings" }}" data-page="settings">{{ t "menu.settings" }}</a>
                 </li>
@@ -226,7 +234,7 @@ var templateCommonMap = map[string]string{
 <div class="pagination">
     <div class="pagination-prev">
         {{ if .ShowPrev }}
-            <a href="{{ .Route }}{{ if gt .PrevOffset 0 }}?offset={{ .PrevOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ else }}{{ if .SearchQuery }}?q={{ .SearchQuery }}{{ end }}{{ end }}" data-page="previous">{{ t "pagination.previous" }}</a>
+            <a href="{{ .Route }}{{ if gt .PrevOffset 0 }}?offset={{ .PrevOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ if .ReadingTime }}&amp;reading_time={{ .ReadingTime }}{{ end }}{{ else if .SearchQuery }}?q={{ .SearchQuery }}{{ else if .ReadingTime }}?reading_time={{ .ReadingTime }}{{ end }}" data-page="previous">{{ t "pagination.previous" }}</a>
         {{ else }}
             {{ t "pagination.previous" }}
         {{ end }}
@@ -234,19 +242,84 @@ var templateCommonMap = map[string]string{
 
     <div class="pagination-next">
         {{ if .ShowNext }}
-            <a href="{{ .Route }}?offset={{ .NextOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}" data-page="next">{{ t "pagination.next" }}</a>
+            <a href="{{ .Route }}?offset={{ .NextOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ if .ReadingTime }}&amp;reading_time={{ .ReadingTime }}{{ end }}" data-page="next">{{ t "pagination.next" }}</a>
         {{ else }}
             {{ t "pagination.next" }}
         {{ end }}
     </div>
 </div>
 {{ end }}
+`,
+	"rule_form": `{{ define "rule_form" }}
+    <label for="form-title">{{ t "form.rule.label.title" }}</label>
+    <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
+
+    <h3>{{ t "form.rule.section.conditions" }}</h3>
+    <div class="form-section">
+        <label for="form-feed">{{ t "form.rule.label.feed" }}</label>
+        <select id="form-feed" name="feed_id">
+            <option value="0">{{ t "form.rule.select.any_feed" }}</option>
+        {{ range .feeds }}
+            <option value="{{ .ID }}" {{ if eq .ID $.form.FeedID }}selected="selected"{{ end }}>{{ .Title }}</option>
+        {{ end }}
+        </select>
+
+        <label for="form-category">{{ t "form.rule.label.category" }}</label>
+        <select id="form-category" name="category_id">
+            <option value="0">{{ t "form.rule.select.any_category" }}</option>
+        {{ range .categories }}
+            <option value="{{ .ID }}" {{ if eq .ID $.form.CategoryID }}selected="selected"{{ end }}>{{ .Title }}</option>
+        {{ end }}
+        </select>
+
+        <label for="form-title-pattern">{{ t "form.rule.label.title_pattern" }}</label>
+        <input type="text" name="title_pattern" id="form-title-pattern" value="{{ .form.TitlePattern }}" spellcheck="false">
+
+        <label for="form-content-pattern">{{ t "form.rule.label.content_pattern" }}</label>
+        <input type="text" name="content_pattern" id="form-content-pattern" value="{{ .form.ContentPattern }}" spellcheck="false">
+
+        <label for="form-author-pattern">{{ t "form.rule.label.author_pattern" }}</label>
+        <input type="text" name="author_pattern" id="form-author-pattern" value="{{ .form.AuthorPattern }}" spellcheck="false">
+
+        <label for="form-older-than">{{ t "form.rule.label.older_than" }}</label>
+        <input type="number" name="older_than" id="form-older-than" value="{{ .form.OlderThan }}" min="0">
+
+        <div class="form-help">{{ t "form.rule.help.conditions" }}</div>
+    </div>
+
+    <h3>{{ t "form.rule.section.actions" }}</h3>
+    <div class="form-section">
+        <label><input type="checkbox" name="mark_as_read" value="1" {{ if .form.MarkAsRead }}checked{{ end }}> {{ t "form.rule.label.mark_as_read" }}</label>
+        <label><input type="checkbox" name="star" value="1" {{ if .form.Star }}checked{{ end }}> {{ t "form.rule.label.star" }}</label>
+        <label><input type="checkbox" name="send_to_integrations" value="1" {{ if .form.SendToIntegrations }}checked{{ end }}> {{ t "form.rule.label.send_to_integrations" }}</label>
+
+        <label for="form-tag">{{ t "form.rule.label.tag" }}</label>
+        <input type="text" name="tag" id="form-tag" value="{{ .form.Tag }}">
+    </div>
+{{ end }}
+`,
+	"site_rule_form": `{{ define "site_rule_form" }}
+    <label for="form-domain">{{ t "form.site_rule.label.domain" }}</label>
+    <input type="text" name="domain" id="form-domain" placeholder="example.org" value="{{ .form.Domain }}" required autofocus spellcheck="false">
+
+    <label for="form-scraper-rules">{{ t "form.feed.label.scraper_rules" }}</label>
+    <input type="text" name="scraper_rules" id="form-scraper-rules" placeholder="article.post ! .share-bar, .related" value="{{ .form.ScraperRules }}" spellcheck="false">
+    <div class="form-help">{{ t "form.feed.help.scraper_rules" }}</div>
+
+    <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
+    <input type="text" name="rewrite_rules" id="form-rewrite-rules" placeholder='add_dynamic_image, remove(".share"), replace("regex"|"replacement")' value="{{ .form.RewriteRules }}" spellcheck="false">
+    <div class="form-help">{{ t "form.feed.help.rewrite_rules" }}</div>
+
+    <label><input type="checkbox" name="disabled" value="1" {{ if .form.Disabled }}checked{{ end }}> {{ t "form.site_rule.label.disabled" }}</label>
+{{ end }}
 `,
 }
 
 var templateCommonMapChecksums = map[string]string{
 	"entry_pagination": "4faa91e2eae150c5e4eab4d258e039dfdd413bab7602f0009360e6d52898e353",
-	"item_meta":        "34deb081a054f2948ad808bdb2c8603d6ab00c58f2f50c4ead0b47ae092888eb",
-	"layout":           "cc9ae6a3c430a6be67787318292e1bcec7bf27e96a4f6664dcebc5d19419cfba",
-	"pagination":       "3386e90c6e1230311459e9a484629bc5d5bf39514a75ef2e73bbbc61142f7abb",
+	"item_meta":        "4d4e2c054f19200c5ef48400d7b688d71a27d78954e519a43b491c17d6941c64",
+	"layout":           "b6a8e63aececfc77e8fafd49182e5d241ef85d5d39e5c18431a6cefb3a7a6642",
+	"pagination":       "72e615aee114328e6fe3ba583bb6d45fe7a9b3bc10f807363c4d17fcd6674955",
+	"rule_form":        "d7361cb289da8c018754360b730af455dd5b2c5f7a66c1402734555c7c71c59d",
+	"site_rule_form":   "e68a6d7046f9805b85ae83fc3cf0a37d3c7f2ee85a9716d3da849b2096b6bb80",
 }
@@ -35,6 +35,7 @@ func (f *funcMap) Map() template.FuncMap {
 		"hasKey":   hasKey,
 		"truncate": truncate,
 		"isEmail":  isEmail,
+		"duration": formatDuration,
 		"baseURL": func() string {
 			return config.Opts.BaseURL()
 		},
@@ -50,14 +51,14 @@ func (f *funcMap) Map() template.FuncMap {
 		"noescape": func(str string) template.HTML {
 			return template.HTML(str)
 		},
-		"proxyFilter": func(data string) string {
-			return imageProxyFilter(f.router, data)
+		"proxyFilter": func(data string, feedID int64) string {
+			return imageProxyFilter(f.router, data, feedID)
 		},
-		"proxyURL": func(link string) string {
+		"proxyURL": func(link string, feedID int64) string {
 			proxyImages := config.Opts.ProxyImages()
 
 			if proxyImages == "all" || (proxyImages != "none" && !url.IsHTTPS(link)) {
-				return proxify(f.router, link)
+				return proxify(f.router, link, feedID)
 			}
 
 			return link
@@ -132,6 +133,14 @@ func isEmail(str string) bool {
 	return true
 }
 
+// formatDuration returns a duration in seconds like a clock, for example "1:02:03" or "2:03".
+func formatDuration(seconds int) string {
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
 func elapsedTime(printer *locale.Printer, tz string, t time.Time) string {
 	if t.IsZero() {
 		return printer.Printf("time_elapsed.not_yet")
@@ -173,7 +182,7 @@ func elapsedTime(printer *locale.Printer, tz string, t time.Time) string {
 	}
 }
 
-func imageProxyFilter(router *mux.Router, data string) string {
+func imageProxyFilter(router *mux.Router, data string, feedID int64) string {
 	proxyImages := config.Opts.ProxyImages()
 	if proxyImages == "none" {
 		return data
@@ -187,7 +196,7 @@ func imageProxyFilter(router *mux.Router, data string) string {
 	doc.Find("img").Each(func(i int, img *goquery.Selection) {
 		if srcAttr, ok := img.Attr("src"); ok {
 			if proxyImages == "all" || !url.IsHTTPS(srcAttr) {
-				img.SetAttr("src", proxify(router, srcAttr))
+				img.SetAttr("src", proxify(router, srcAttr, feedID))
 			}
 		}
 	})
@@ -196,7 +205,8 @@ func imageProxyFilter(router *mux.Router, data string) string {
 	return output
 }
 
-func proxify(router *mux.Router, link string) string {
+func proxify(router *mux.Router, link string, feedID int64) string {
 	// We use base64 url encoding to avoid slash in the URL.
-	return route.Path(router, "proxy", "encodedURL", base64.URLEncoding.EncodeToString([]byte(link)))
+	// The feed ID allows the proxy to download the media with the settings of the feed.
+	return route.Path(router, "proxy", "feedID", feedID, "encodedURL", base64.URLEncoding.EncodeToString([]byte(link)))
 }
@@ -101,6 +101,22 @@ func TestIsEmail(t *testing.T) {
 	}
 }
 
+func TestFormatDuration(t *testing.T) {
+	scenarios := map[int]string{
+		0:    "0:00",
+		59:   "0:59",
+		123:  "2:03",
+		3600: "1:00:00",
+		3723: "1:02:03",
+	}
+
+	for seconds, expected := range scenarios {
+		if result := formatDuration(seconds); result != expected {
+			t.Errorf(`Unexpected result for %d, got %q instead of %q`, seconds, result, expected)
+		}
+	}
+}
+
 func TestElapsedTime(t *testing.T) {
 	printer := locale.NewPrinter("en_US")
 	var dt = []struct {
@@ -143,11 +159,11 @@ func TestProxyFilterWithHttpDefault(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{feedID}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
-	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
+	output := imageProxyFilter(r, input, 1)
+	expected := `<p><img src="/proxy/1/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
@@ -166,10 +182,10 @@ func TestProxyFilterWithHttpsDefault(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{feedID}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := imageProxyFilter(r, input, 1)
 	expected := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
 
 	if expected != output {
@@ -189,10 +205,10 @@ func TestProxyFilterWithHttpNever(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{feedID}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := imageProxyFilter(r, input, 1)
 	expected := input
 
 	if expected != output {
@@ -212,10 +228,10 @@ func TestProxyFilterWithHttpsNever(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{feedID}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := imageProxyFilter(r, input, 1)
 	expected := input
 
 	if expected != output {
@@ -235,11 +251,11 @@ func TestProxyFilterWithHttpAlways(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{feedID}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
-	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
+	output := imageProxyFilter(r, input, 1)
+	expected := `<p><img src="/proxy/1/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
@@ -258,11 +274,11 @@ func TestProxyFilterWithHttpsAlways(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{feedID}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
-	expected := `<p><img src="/proxy/aHR0cHM6Ly93ZWJzaXRlL2ZvbGRlci9pbWFnZS5wbmc=" alt="Test"/></p>`
+	output := imageProxyFilter(r, input, 1)
+	expected := `<p><img src="/proxy/1/aHR0cHM6Ly93ZWJzaXRlL2ZvbGRlci9pbWFnZS5wbmc=" alt="Test"/></p>`
 
 	if expected != output {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
@@ -281,11 +297,11 @@ func TestProxyFilterWithHttpInvalid(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{feedID}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
-	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
+	output := imageProxyFilter(r, input, 1)
+	expected := `<p><img src="/proxy/1/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
@@ -304,10 +320,10 @@ func TestProxyFilterWithHttpsInvalid(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{feedID}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := imageProxyFilter(r, input, 1)
 	expected := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
 
 	if expected != output {
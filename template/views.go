@@ -15,6 +15,12 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
         </li>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
         <li>
             <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
         </li>
@@ -102,6 +108,15 @@ var templateViewsMap = map[string]string{
                     - Using a different input name doesn't change anything
                 -->
                 <input type="text" name="feed_password" id="form-feed-password" value="{{ .form.Password }}">
+
+                <label for="form-proxy-url">{{ t "form.feed.label.proxy_url" }}</label>
+                <input type="text" name="proxy_url" id="form-proxy-url" placeholder="socks5://127.0.0.1:9050" value="{{ .form.ProxyURL }}">
+
+                <label for="form-headers">{{ t "form.feed.label.headers" }}</label>
+                <textarea name="headers" id="form-headers" placeholder="Authorization: Bearer ..." autocomplete="off">{{ .form.Headers }}</textarea>
+
+                <label for="form-cookie">{{ t "form.feed.label.cookie" }}</label>
+                <input type="text" name="cookie" id="form-cookie" placeholder="name=value; name2=value2" value="{{ .form.Cookie }}" autocomplete="off">
             </div>
         </details>
 
@@ -224,6 +239,15 @@ var templateViewsMap = map[string]string{
             <a href="{{ route "categoryEntries" "categoryID" .category.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
         </li>
     {{ end }}
+    {{ if .readingTime }}
+    <li>
+        <a href="{{ .pagination.Route }}">{{ t "menu.show_all_reading_times" }}</a>
+    </li>
+    {{ else }}
+    <li>
+        <a href="{{ .pagination.Route }}?reading_time=short">{{ t "menu.show_only_short_reads" }}</a>
+    </li>
+    {{ end }}
     </ul>
 </section>
 
@@ -287,6 +311,9 @@ var templateViewsMap = map[string]string{
     <input type="hidden" name="user_agent" value="{{ .form.UserAgent }}">
     <input type="hidden" name="feed_username" value="{{ .form.Username }}">
     <input type="hidden" name="feed_password" value="{{ .form.Password }}">
+    <input type="hidden" name="proxy_url" value="{{ .form.ProxyURL }}">
+    <input type="hidden" name="headers" value="{{ .form.Headers }}">
+    <input type="hidden" name="cookie" value="{{ .form.Cookie }}">
     {{ if .form.Crawler }}
         <input type="hidden" name="crawler" value="1">
     {{ end }}
@@ -333,6 +360,64 @@ var templateViewsMap = map[string]string{
     </div>
 </form>
 {{ end }}
+`,
+	"create_rule": `{{ define "title"}}{{ t "page.new_rule.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_rule.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "saveRule" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    {{ template "rule_form" . }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "rules" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+`,
+	"create_site_rule": `{{ define "title"}}{{ t "page.new_site_rule.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_site_rule.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "saveSiteRule" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    {{ template "site_rule_form" . }}
+
+    {{ if .user.IsAdmin }}
+    <label><input type="checkbox" name="global" value="1" {{ if .form.Global }}checked{{ end }}> {{ t "form.site_rule.label.global" }}</label>
+    {{ end }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "siteRules" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
 `,
 	"create_user": `{{ define "title"}}{{ t "page.new_user.title" }}{{ end }}
 
@@ -346,6 +431,12 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
         </li>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
         <li>
             <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
         </li>
@@ -441,6 +532,9 @@ var templateViewsMap = map[string]string{
     <div class="alert alert-error">
         <h3>{{ t "page.edit_feed.last_parsing_error" }}</h3>
         <p>{{ t .feed.ParsingErrorMsg }}</p>
+        {{ if not .feed.Disabled }}
+        <p>{{ t "page.feeds.next_retry" }} <time datetime="{{ isodate .feed.NextCheckAt }}">{{ isodate .feed.NextCheckAt }}</time></p>
+        {{ end }}
     </div>
     {{ end }}
 
@@ -476,11 +570,38 @@ var templateViewsMap = map[string]string{
 	    <label for="form-user-agent">{{ t "form.feed.label.user_agent" }}</label>
 	    <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ .defaultUserAgent }}" value="{{ .form.UserAgent }}">
 
+        <label for="form-proxy-url">{{ t "form.feed.label.proxy_url" }}</label>
+        <input type="text" name="proxy_url" id="form-proxy-url" placeholder="socks5://127.0.0.1:9050" value="{{ .form.ProxyURL }}">
+
+        <label for="form-headers">{{ t "form.feed.label.headers" }}</label>
+        <textarea name="headers" id="form-headers" placeholder="Authorization: Bearer ..." autocomplete="off">{{ .form.Headers }}</textarea>
+
+        <label for="form-cookie">{{ t "form.feed.label.cookie" }}</label>
+        <input type="text" name="cookie" id="form-cookie" placeholder="name=value; name2=value2" value="{{ .form.Cookie }}" autocomplete="off">
+        {{ if or .feed.EncryptedHeaders .feed.EncryptedCookie }}
+        <div class="form-help">{{ t "form.feed.help.undecryptable_headers" }}</div>
+        {{ end }}
+
         <label for="form-scraper-rules">{{ t "form.feed.label.scraper_rules" }}</label>
-        <input type="text" name="scraper_rules" id="form-scraper-rules" value="{{ .form.ScraperRules }}">
+        <input type="text" name="scraper_rules" id="form-scraper-rules" placeholder="article.post ! .share-bar, .related" value="{{ .form.ScraperRules }}">
+        <div class="form-help">{{ t "form.feed.help.scraper_rules" }}</div>
 
         <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
-        <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}">
+        <input type="text" name="rewrite_rules" id="form-rewrite-rules" placeholder='add_dynamic_image, remove(".share"), replace("regex"|"replacement")' value="{{ .form.RewriteRules }}">
+        <div class="form-help">{{ t "form.feed.help.rewrite_rules" }}</div>
+
+        <label for="form-keep-rules">{{ t "form.feed.label.keep_rules" }}</label>
+        <textarea name="keep_rules" id="form-keep-rules" placeholder="category:(?i)^golang$">{{ .form.KeepRules }}</textarea>
+
+        <label for="form-block-rules">{{ t "form.feed.label.block_rules" }}</label>
+        <textarea name="block_rules" id="form-block-rules" placeholder="title:(?i)sponsored">{{ .form.BlockRules }}</textarea>
+        <div class="form-help">{{ t "form.feed.help.filter_rules" }}</div>
+
+        <label for="form-filter-action">{{ t "form.feed.label.filter_action" }}</label>
+        <select id="form-filter-action" name="filter_action">
+            <option value="drop" {{ if eq "drop" .form.FilterAction }}selected="selected"{{ end }}>{{ t "form.feed.select.filter_drop" }}</option>
+            <option value="read" {{ if eq "read" .form.FilterAction }}selected="selected"{{ end }}>{{ t "form.feed.select.filter_read" }}</option>
+        </select>
 
         <label for="form-category">{{ t "form.feed.label.category" }}</label>
         <select id="form-category" name="category_id">
@@ -490,6 +611,10 @@ var templateViewsMap = map[string]string{
         </select>
 
         <label><input type="checkbox" name="crawler" value="1" {{ if .form.Crawler }}checked{{ end }}> {{ t "form.feed.label.crawler" }}</label>
+        <label><input type="checkbox" name="mark_unread_on_update" value="1" {{ if .form.MarkUnreadOnUpdate }}checked{{ end }}> {{ t "form.feed.label.mark_unread_on_update" }}</label>
+        <label><input type="checkbox" name="keep_tracking_parameters" value="1" {{ if .form.KeepTrackingParameters }}checked{{ end }}> {{ t "form.feed.label.keep_tracking_parameters" }}</label>
+        <label><input type="checkbox" name="resolve_final_url" value="1" {{ if .form.ResolveFinalURL }}checked{{ end }}> {{ t "form.feed.label.resolve_final_url" }}</label>
+        <label><input type="checkbox" name="disabled" value="1" {{ if .form.Disabled }}checked{{ end }}> {{ t "form.feed.label.disabled" }}</label>
 
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "feeds" }}">{{ t "action.cancel" }}</a>
@@ -499,11 +624,39 @@ var templateViewsMap = map[string]string{
     <div class="panel">
         <ul>
             <li><strong>{{ t "page.edit_feed.last_check" }} </strong><time datetime="{{ isodate .feed.CheckedAt }}" title="{{ isodate .feed.CheckedAt }}">{{ elapsed $.user.Timezone .feed.CheckedAt }}</time></li>
+            <li><strong>{{ t "page.edit_feed.next_check" }} </strong><time datetime="{{ isodate .feed.NextCheckAt }}" title="{{ isodate .feed.NextCheckAt }}">{{ isodate .feed.NextCheckAt }}</time></li>
+            {{ if .feed.HubURL }}
+            <li><strong>{{ t "page.edit_feed.websub_hub" }} </strong>{{ .feed.HubURL }}{{ if .feed.HasWebSubLease }} ({{ t "page.edit_feed.websub_lease" (isodate .feed.HubLeaseExpiresAt) }}){{ end }}</li>
+            {{ end }}
             <li><strong>{{ t "page.edit_feed.etag_header" }} </strong>{{ if .feed.EtagHeader }}{{ .feed.EtagHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
             <li><strong>{{ t "page.edit_feed.last_modified_header" }} </strong>{{ if .feed.LastModifiedHeader }}{{ .feed.LastModifiedHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
         </ul>
     </div>
 
+    {{ if .fetches }}
+    <h3>{{ t "page.edit_feed.fetch_history" }}</h3>
+    <table>
+        <tr>
+            <th>{{ t "page.edit_feed.fetch_history.date" }}</th>
+            <th>{{ t "page.edit_feed.fetch_history.status" }}</th>
+            <th>{{ t "page.edit_feed.fetch_history.duration" }}</th>
+            <th>{{ t "page.edit_feed.fetch_history.size" }}</th>
+            <th>{{ t "page.edit_feed.fetch_history.entries" }}</th>
+            <th>{{ t "page.edit_feed.fetch_history.error" }}</th>
+        </tr>
+        {{ range .fetches }}
+        <tr>
+            <td class="column-20"><time datetime="{{ isodate .FetchedAt }}" title="{{ isodate .FetchedAt }}">{{ elapsed $.user.Timezone .FetchedAt }}</time></td>
+            <td>{{ if .StatusCode }}{{ .StatusCode }}{{ end }}{{ if .NotModified }} ({{ t "page.edit_feed.fetch_history.not_modified" }}){{ end }}{{ if .NewURL }}<br>{{ t "page.edit_feed.fetch_history.moved" .PreviousURL .NewURL }}{{ end }}</td>
+            <td>{{ .Duration }} ms</td>
+            <td>{{ .Size }}</td>
+            <td>{{ t "page.edit_feed.fetch_history.entries_count" .CreatedEntries .UpdatedEntries }}</td>
+            <td>{{ .Error }}</td>
+        </tr>
+        {{ end }}
+    </table>
+    {{ end }}
+
     <div class="alert alert-error">
         <a href="#"
             data-confirm="true"
@@ -517,6 +670,70 @@ var templateViewsMap = map[string]string{
     </div>
 {{ end }}
 
+{{ end }}
+`,
+	"edit_rule": `{{ define "title"}}{{ t "page.edit_rule.title" .rule.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.edit_rule.title" .rule.Title }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "createRule" }}">{{ t "menu.create_rule" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "updateRule" "ruleID" .rule.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    {{ template "rule_form" . }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "rules" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+`,
+	"edit_site_rule": `{{ define "title"}}{{ t "page.edit_site_rule.title" .siteRule.Domain }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.edit_site_rule.title" .siteRule.Domain }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "createSiteRule" }}">{{ t "menu.create_site_rule" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "updateSiteRule" "siteRuleID" .siteRule.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    {{ if .siteRule.Global }}
+        <p class="alert alert-info">{{ t "page.site_rules.global_help" }}</p>
+    {{ end }}
+
+    {{ template "site_rule_form" . }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "siteRules" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
 {{ end }}
 `,
 	"edit_user": `{{ define "title"}}{{ t "page.edit_user.title" .selected_user.Username }}{{ end }}
@@ -531,6 +748,12 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
         </li>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
         <li>
             <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
         </li>
@@ -578,6 +801,9 @@ var templateViewsMap = map[string]string{
         <h1>
             <a href="{{ .entry.URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .entry.Title }}</a>
         </h1>
+        {{ if .entry.Subtitle }}
+            <p class="entry-subtitle">{{ .entry.Subtitle }}</p>
+        {{ end }}
         <div class="entry-actions">
             <ul>
                 <li>
@@ -619,6 +845,11 @@ var templateViewsMap = map[string]string{
                         data-label-done="{{ t "entry.scraper.completed" }}"
                         >{{ t "entry.scraper.label" }}</a>
                 </li>
+                {{ if .entry.UpdatedAt }}
+                    <li>
+                        <a href="{{ route "entryRevisions" "entryID" .entry.ID }}" title="{{ t "entry.revisions.title" }}">{{ t "entry.revisions.label" }}</a>
+                    </li>
+                {{ end }}
                 {{ if .entry.CommentsURL }}
                     <li>
                         <a href="{{ .entry.CommentsURL }}" title="{{ t "entry.comments.title" }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ t "entry.comments.label" }}</a>
@@ -648,6 +879,9 @@ var templateViewsMap = map[string]string{
         </div>
         <div class="entry-date">
             <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed $.user.Timezone .entry.Date }}</time>
+            {{ if .entry.UpdatedAt }}
+                – {{ t "entry.updated" }} <time datetime="{{ isodate .entry.UpdatedAt }}" title="{{ isodate .entry.UpdatedAt }}">{{ elapsed $.user.Timezone .entry.UpdatedAt }}</time>
+            {{ end }}
         </div>
     </header>
     {{ if gt (len .entry.Content) 120 }}
@@ -656,45 +890,157 @@ var templateViewsMap = map[string]string{
     </div>
     {{ end }}
     <article class="entry-content">
-        {{ noescape (proxyFilter .entry.Content) }}
+        {{ noescape (proxyFilter .entry.Content .entry.FeedID) }}
     </article>
     {{ if .entry.Enclosures }}
     <aside class="entry-enclosures">
         <h3>{{ t "page.entry.attachments" }}</h3>
+        {{ if or .entry.Season .entry.Episode .entry.Explicit }}
+            <p class="entry-podcast">
+                {{ if .entry.Season }}<span>{{ t "entry.podcast.season" .entry.Season }}</span>{{ end }}
+                {{ if .entry.Episode }}<span>{{ t "entry.podcast.episode" .entry.Episode }}</span>{{ end }}
+                {{ if .entry.Explicit }}<span class="entry-podcast-explicit">{{ t "entry.podcast.explicit" }}</span>{{ end }}
+            </p>
+        {{ end }}
         {{ range .entry.Enclosures }}
             <div class="entry-enclosure">
                 {{ if hasPrefix .MimeType "audio/" }}
                     <div class="enclosure-audio">
-                        <audio controls preload="metadata">
+                        {{ if and $.entry.ThumbnailURL (not ($.entry.Enclosures.Contains $.entry.ThumbnailURL)) }}
+                            <img class="enclosure-artwork" src="{{ proxyURL $.entry.ThumbnailURL $.entry.FeedID }}" alt="{{ $.entry.Title }}">
+                        {{ end }}
+                        <audio controls preload="metadata"
+                            data-progression-url="{{ route "saveEnclosureProgression" "enclosureID" .ID }}"
+                            data-last-position="{{ .MediaProgression }}"
+                            {{ if and $.user.MarkReadOnMediaCompletion (eq $.entry.Status "unread") }}data-mark-read-on-completion="true" data-entry-id="{{ $.entry.ID }}"{{ end }}>
                             <source src="{{ .URL }}" type="{{ .MimeType }}">
                         </audio>
                     </div>
                 {{ else if hasPrefix .MimeType "video/" }}
                     <div class="enclosure-video">
-                        <video controls preload="metadata">
+                        <video controls preload="metadata"
+                            data-progression-url="{{ route "saveEnclosureProgression" "enclosureID" .ID }}"
+                            data-last-position="{{ .MediaProgression }}"
+                            {{ if and $.user.MarkReadOnMediaCompletion (eq $.entry.Status "unread") }}data-mark-read-on-completion="true" data-entry-id="{{ $.entry.ID }}"{{ end }}>
                             <source src="{{ .URL }}" type="{{ .MimeType }}">
                         </video>
                     </div>
                 {{ else if hasPrefix .MimeType "image/" }}
                     <div class="enclosure-image">
-                        <img src="{{ proxyURL .URL }}" title="{{ .URL }} ({{ .MimeType }})" alt="{{ .URL }} ({{ .MimeType }})">
+                        <img src="{{ proxyURL .URL $.entry.FeedID }}" title="{{ .URL }} ({{ .MimeType }})" alt="{{ .URL }} ({{ .MimeType }})">
+                    </div>
+                {{ end }}
+
+                {{ if .IsAudioOrVideo }}
+                    <div class="enclosure-playback-speed">
+                        <label>{{ t "entry.media.playback_speed" }}
+                            <select data-playback-speed>
+                                <option value="0.75">0.75×</option>
+                                <option value="1" selected>1×</option>
+                                <option value="1.25">1.25×</option>
+                                <option value="1.5">1.5×</option>
+                                <option value="1.75">1.75×</option>
+                                <option value="2">2×</option>
+                            </select>
+                        </label>
                     </div>
                 {{ end }}
 
                 <div class="entry-enclosure-download">
                     <a href="{{ .URL }}" title="{{ .URL }} ({{ .MimeType }})" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ t "action.download" }}</a>
+                    {{ if gt .Duration 0 }}<span class="enclosure-duration">{{ duration .Duration }}</span>{{ end }}
                     <small>({{ .URL }})</small>
                 </div>
             </div>
         {{ end }}
     </aside>
     {{ end }}
+    {{ if .entry.Duplicates }}
+    <aside class="entry-duplicates">
+        <h3>{{ t "page.entry.duplicates" }}</h3>
+        <ul>
+        {{ range .entry.Duplicates }}
+            <li>
+                {{ if eq .Status "removed" }}
+                    <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Feed.Title }}</a>
+                {{ else }}
+                    <a href="{{ route "feedEntry" "feedID" .FeedID "entryID" .ID }}">{{ .Feed.Title }}</a>
+                {{ end }}
+                – <time datetime="{{ isodate .Date }}" title="{{ isodate .Date }}">{{ elapsed $.user.Timezone .Date }}</time>
+            </li>
+        {{ end }}
+        </ul>
+    </aside>
+    {{ end }}
+    <aside class="entry-tags">
+        <h3>{{ t "page.entry.tags" }}</h3>
+        {{ if .entry.Tags }}
+        <ul>
+        {{ range .entry.Tags }}
+            <li><a href="{{ route "tagEntries" "tagID" .ID }}">{{ .Title }}</a></li>
+        {{ end }}
+        </ul>
+        {{ end }}
+        <form action="{{ route "updateEntryTags" "entryID" .entry.ID }}" method="post" autocomplete="off">
+            <input type="hidden" name="csrf" value="{{ .csrf }}">
+            <label for="form-tags">{{ t "form.entry.label.tags" }}</label>
+            <input type="text" name="tags" id="form-tags" value="{{ range $i, $tag := .entry.Tags }}{{ if $i }}, {{ end }}{{ $tag.Title }}{{ end }}">
+            <div class="buttons">
+                <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button>
+            </div>
+        </form>
+    </aside>
 </section>
 
 <div class="pagination-bottom">
     {{ template "entry_pagination" . }}
 </div>
 {{ end }}
+`,
+	"entry_revisions": `{{ define "title"}}{{ t "page.entry_revisions.title" }} - {{ .entry.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.entry_revisions.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "feedEntry" "feedID" .entry.FeedID "entryID" .entry.ID }}">{{ .entry.Title }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .changes }}
+    <p class="alert alert-info">{{ t "alert.no_revision" }}</p>
+{{ else }}
+    {{ range .changes }}
+    <article class="entry-revision">
+        <h3>
+            {{ t "page.entry_revisions.changed_at" }}
+            <time datetime="{{ isodate .Revision.CreatedAt }}" title="{{ isodate .Revision.CreatedAt }}">{{ elapsed $.user.Timezone .Revision.CreatedAt }}</time>
+        </h3>
+        {{ if .Title.HasChanges }}
+        <div class="entry-revision-title">
+            {{ template "diff_changes" .Title }}
+        </div>
+        {{ end }}
+        <div class="entry-revision-content">
+            {{ if .Content.HasChanges }}
+                {{ template "diff_changes" .Content }}
+            {{ else }}
+                <em>{{ t "page.entry_revisions.same_content" }}</em>
+            {{ end }}
+        </div>
+    </article>
+    {{ end }}
+{{ end }}
+
+{{ end }}
+
+{{ define "diff_changes" }}
+{{- range . -}}
+    {{- if eq .Operation "insert" }}<ins>{{ .Text }}</ins> {{ else if eq .Operation "delete" }}<del>{{ .Text }}</del> {{ else }}{{ .Text }} {{ end -}}
+{{- end -}}
+{{ end }}
 `,
 	"feed_entries": `{{ define "title"}}{{ .feed.Title }} ({{ .total }}){{ end }}
 
@@ -719,6 +1065,15 @@ var templateViewsMap = map[string]string{
             <a href="{{ route "feedEntries" "feedID" .feed.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
         </li>
         {{ end }}
+        {{ if .readingTime }}
+        <li>
+            <a href="{{ .pagination.Route }}">{{ t "menu.show_all_reading_times" }}</a>
+        </li>
+        {{ else }}
+        <li>
+            <a href="{{ .pagination.Route }}?reading_time=short">{{ t "menu.show_only_short_reads" }}</a>
+        </li>
+        {{ end }}
         <li>
             <a href="{{ route "refreshFeed" "feedID" .feed.ID }}">{{ t "menu.refresh_feed" }}</a>
         </li>
@@ -832,6 +1187,11 @@ var templateViewsMap = map[string]string{
                     <li>
                         {{ t "page.feeds.last_check" }} <time datetime="{{ isodate .CheckedAt }}" title="{{ isodate .CheckedAt }}">{{ elapsed $.user.Timezone .CheckedAt }}</time>
                     </li>
+                    {{ if .Disabled }}
+                    <li>
+                        <strong>{{ t "page.feeds.disabled" }}</strong>
+                    </li>
+                    {{ end }}
                 </ul>
                 <ul>
                     <li>
@@ -855,6 +1215,7 @@ var templateViewsMap = map[string]string{
                 <div class="parsing-error">
                     <strong title="{{ .ParsingErrorMsg }}" class="parsing-error-count">{{ plural "page.feeds.error_count" .ParsingErrorCount .ParsingErrorCount }}</strong>
                     - <small class="parsing-error-message">{{ .ParsingErrorMsg }}</small>
+                    {{ if not .Disabled }}- <small class="parsing-error-next-retry">{{ t "page.feeds.next_retry" }} <time datetime="{{ isodate .NextCheckAt }}">{{ isodate .NextCheckAt }}</time></small>{{ end }}
                 </div>
             {{ end }}
         </article>
@@ -946,6 +1307,12 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "settings" }}">{{ t "menu.settings" }}</a>
         </li>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
         <li>
             <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
         </li>
@@ -1126,6 +1493,93 @@ var templateViewsMap = map[string]string{
     </div>
     {{ end }}
 </section>
+{{ end }}
+`,
+	"rules": `{{ define "title"}}{{ t "page.rules.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.rules.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "settings" }}">{{ t "menu.settings" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "createRule" }}">{{ t "menu.create_rule" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .rules }}
+    <p class="alert">{{ t "alert.no_rule" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .rules }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    <a href="{{ route "editRule" "ruleID" .ID }}">{{ .Title }}</a>
+                </span>
+            </div>
+            <div class="item-meta">
+                <ul>
+                    {{ if .FeedID }}
+                    <li>{{ t "page.rules.feed" (index $.feedTitles .FeedID) }}</li>
+                    {{ end }}
+                    {{ if .CategoryID }}
+                    <li>{{ t "page.rules.category" (index $.categoryTitles .CategoryID) }}</li>
+                    {{ end }}
+                    {{ if .TitlePattern }}
+                    <li>{{ t "page.rules.title_pattern" .TitlePattern }}</li>
+                    {{ end }}
+                    {{ if .ContentPattern }}
+                    <li>{{ t "page.rules.content_pattern" .ContentPattern }}</li>
+                    {{ end }}
+                    {{ if .AuthorPattern }}
+                    <li>{{ t "page.rules.author_pattern" .AuthorPattern }}</li>
+                    {{ end }}
+                    {{ if .OlderThan }}
+                    <li>{{ plural "page.rules.older_than" .OlderThan .OlderThan }}</li>
+                    {{ end }}
+                </ul>
+                <ul>
+                    <li>{{ plural "page.rules.match_count" .MatchCount .MatchCount }}</li>
+                </ul>
+                <ul>
+                    <li>
+                        <a href="{{ route "editRule" "ruleID" .ID }}">{{ t "action.edit" }}</a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "applyRule" "ruleID" .ID }}">{{ t "page.rules.apply" }}</a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "removeRule" "ruleID" .ID }}">{{ t "action.remove" }}</a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
 {{ end }}
 `,
 	"search_entries": `{{ define "title"}}{{ t "page.search.title" }} ({{ .total }}){{ end }}
@@ -1171,6 +1625,12 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
         </li>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
         {{ if .user.IsAdmin }}
         <li>
             <a href="{{ route "users" }}">{{ t "menu.users" }}</a>
@@ -1225,6 +1685,12 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
         </li>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
         <li>
             <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
         </li>
@@ -1282,6 +1748,17 @@ var templateViewsMap = map[string]string{
         <option value="desc" {{ if eq "desc" $.form.EntryDirection }}selected="selected"{{ end }}>{{ t "form.prefs.select.recent_first" }}</option>
     </select>
 
+    <label for="form-duplicate-policy">{{ t "form.prefs.label.duplicate_policy" }}</label>
+    <select id="form-duplicate-policy" name="duplicate_policy">
+        <option value="none" {{ if eq "none" $.form.DuplicatePolicy }}selected="selected"{{ end }}>{{ t "form.prefs.select.duplicate_none" }}</option>
+        <option value="read" {{ if eq "read" $.form.DuplicatePolicy }}selected="selected"{{ end }}>{{ t "form.prefs.select.duplicate_read" }}</option>
+        <option value="group" {{ if eq "group" $.form.DuplicatePolicy }}selected="selected"{{ end }}>{{ t "form.prefs.select.duplicate_group" }}</option>
+    </select>
+
+    <label><input type="checkbox" name="duplicate_match_title" value="1" {{ if .form.DuplicateMatchTitle }}checked{{ end }}> {{ t "form.prefs.label.duplicate_match_title" }}</label>
+
+    <label><input type="checkbox" name="mark_read_on_media_completion" value="1" {{ if .form.MarkReadOnMediaCompletion }}checked{{ end }}> {{ t "form.prefs.label.mark_read_on_media_completion" }}</label>
+
     <label><input type="checkbox" name="keyboard_shortcuts" value="1" {{ if .form.KeyboardShortcuts }}checked{{ end }}> {{ t "form.prefs.label.keyboard_shortcuts" }}</label>
 
     <div class="buttons">
@@ -1299,6 +1776,167 @@ var templateViewsMap = map[string]string{
 </div>
 {{ end }}
 
+{{ end }}
+`,
+	"site_rules": `{{ define "title"}}{{ t "page.site_rules.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.site_rules.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "settings" }}">{{ t "menu.settings" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "createSiteRule" }}">{{ t "menu.create_site_rule" }}</a>
+        </li>
+    </ul>
+</section>
+
+<p class="alert alert-info">{{ t "page.site_rules.help" }}</p>
+
+{{ if not .siteRules }}
+    <p class="alert">{{ t "alert.no_site_rule" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .siteRules }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if or (not .Global) $.user.IsAdmin }}
+                        <a href="{{ route "editSiteRule" "siteRuleID" .ID }}">{{ .Domain }}</a>
+                    {{ else }}
+                        {{ .Domain }}
+                    {{ end }}
+                </span>
+            </div>
+            <div class="item-meta">
+                <ul>
+                    <li>{{ if .Global }}{{ t "page.site_rules.global" }}{{ else }}{{ t "page.site_rules.private" }}{{ end }}</li>
+                    {{ if .Disabled }}
+                    <li>{{ t "page.site_rules.disabled" }}</li>
+                    {{ end }}
+                    {{ if .ScraperRules }}
+                    <li>{{ t "page.site_rules.scraper_rules" .ScraperRules }}</li>
+                    {{ end }}
+                    {{ if .RewriteRules }}
+                    <li>{{ t "page.site_rules.rewrite_rules" .RewriteRules }}</li>
+                    {{ end }}
+                </ul>
+                {{ if or (not .Global) $.user.IsAdmin }}
+                <ul>
+                    <li>
+                        <a href="{{ route "editSiteRule" "siteRuleID" .ID }}">{{ t "action.edit" }}</a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "removeSiteRule" "siteRuleID" .ID }}">{{ t "action.remove" }}</a>
+                    </li>
+                </ul>
+                {{ end }}
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
+{{ if .builtinRules }}
+<h3>{{ t "page.site_rules.builtin" }}</h3>
+<div class="items">
+    {{ range .builtinRules }}
+    <article class="item">
+        <div class="item-header">
+            <span class="item-title">{{ .Domain }}</span>
+        </div>
+        <div class="item-meta">
+            <ul>
+                {{ if .ScraperRules }}
+                <li>{{ t "page.site_rules.scraper_rules" .ScraperRules }}</li>
+                {{ end }}
+                {{ if .RewriteRules }}
+                <li>{{ t "page.site_rules.rewrite_rules" .RewriteRules }}</li>
+                {{ end }}
+            </ul>
+            <ul>
+                <li>
+                    <a href="{{ route "createSiteRule" }}?domain={{ .Domain }}&amp;scraper_rules={{ .ScraperRules }}&amp;rewrite_rules={{ .RewriteRules }}">{{ t "page.site_rules.override" }}</a>
+                </li>
+            </ul>
+        </div>
+    </article>
+    {{ end }}
+</div>
+{{ end }}
+
+{{ end }}
+`,
+	"tag_entries": `{{ define "title"}}{{ .tag.Title }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ .tag.Title }} ({{ .total }})</h1>
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_tag_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ route "tagEntry" "tagID" $.tag.ID "entryID" .ID }}">{{ .Title }}</a>
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
+{{ end }}
+`,
+	"tags": `{{ define "title"}}{{ t "page.tags.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.tags.title" }} ({{ .total }})</h1>
+</section>
+
+{{ if not .tags }}
+    <p class="alert alert-info">{{ t "alert.no_tag" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .tags }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    <a href="{{ route "tagEntries" "tagID" .ID }}">{{ .Title }}</a>
+                </span>
+            </div>
+            <div class="item-meta">
+                <ul>
+                    <li>{{ plural "page.tags.entry_count" .EntryCount .EntryCount }}</li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
 {{ end }}
 `,
 	"unread_entries": `{{ define "title"}}{{ t "page.unread.title" }} {{ if gt .countUnread 0 }}({{ .countUnread }}){{ end }} {{ end }}
@@ -1306,8 +1944,8 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.unread.title" }} (<span class="unread-counter">{{ .countUnread }}</span>)</h1>
-    {{ if .entries }}
     <ul>
+        {{ if .entries }}
         <li>
             <a href="#" data-on-click="markPageAsRead">{{ t "menu.mark_page_as_read" }}</a>
         </li>
@@ -1316,8 +1954,17 @@ var templateViewsMap = map[string]string{
                data-label-new-state="{{ t "menu.mark_all_as_read_wip" }}"
                href="{{ route "markAllAsRead" }}">{{ t "menu.mark_all_as_read" }}</a>
         </li>
+        {{ end }}
+        {{ if .readingTime }}
+        <li>
+            <a href="{{ .pagination.Route }}">{{ t "menu.show_all_reading_times" }}</a>
+        </li>
+        {{ else }}
+        <li>
+            <a href="{{ .pagination.Route }}?reading_time=short">{{ t "menu.show_only_short_reads" }}</a>
+        </li>
+        {{ end }}
     </ul>
-    {{ end }}
 </section>
 
 {{ if not .entries }}
@@ -1364,6 +2011,12 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
         </li>
+        <li>
+            <a href="{{ route "rules" }}">{{ t "menu.rules" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "siteRules" }}">{{ t "menu.site_rules" }}</a>
+        </li>
         <li>
             <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
         </li>
@@ -1419,27 +2072,36 @@ var templateViewsMap = map[string]string{
 }
 
 var templateViewsMapChecksums = map[string]string{
-	"about":               "844e3313c33ae31a74b904f6ef5d60299773620d8450da6f760f9f317217c51e",
-	"add_subscription":    "a0f1d2bc02b6adc83dbeae593f74d9b936102cd6dd73302cdbec2137cafdcdd9",
+	"about":               "48a7a9e4dc6ab5b7a65b98d6c001e4b9d967e99918f4486e253905d56540d1fe",
+	"add_subscription":    "ee8557b323f67198757399ffd7670c65e8217bb20bb37c785a3f6605421901ba",
 	"bookmark_entries":    "609f4b2342152fe495a219a32f17a4528b01807d61f53cee0cbebf728be73c42",
 	"categories":          "642ee3cddbd825ee6ab5a77caa0d371096b55de0f1bd4ae3055b8c8a70507d8d",
-	"category_entries":    "8ed501d58fd659c6f505d200f5f92dc2d3f8ed8893c7a8076d05ca54c9adb944",
-	"choose_subscription": "33c04843d7c1b608d034e605e52681822fc6d79bc6b900c04915dd9ebae584e2",
+	"category_entries":    "b8de2e10f1efb2cb15d45db92c64a8418c5402fe2df2507d7dee0a29b94107f4",
+	"choose_subscription": "0bffdf7cb75bbf9499e1ec7619242e200bddca44391f69acb99c63ae6149c297",
 	"create_category":     "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
-	"create_user":         "1e940be3afefc0a5c6273bbadcddc1e29811e9548e5227ac2adfe697ca5ce081",
+	"create_rule":         "182f8210898fa5923c7d05b18854e56da23e0c7ea6bfe3bc72786471ad04d947",
+	"create_site_rule":    "beb3923b341904168f18ee0d8ffd6e2207224768d2db298ed860f6f7c0ddd157",
+	"create_user":         "a8c07a3d334e5158e59b902d2acb01374d5ea91255764663cb3f32dcd2c3fe71",
 	"edit_category":       "daf073d2944a180ce5aaeb80b597eb69597a50dff55a9a1d6cf7938b48d768cb",
-	"edit_feed":           "ab30c31a4385a7b16c54baa78bdcb93a57181ed1c5018ce097d7eb50673bb995",
-	"edit_user":           "f4f99412ba771cfca2a2a42778b023b413c5494e9a287053ba8cf380c2865c5f",
-	"entry":               "1626bf4dd3223b2f730865676162aa0a9f0a0e009cdea90f705230542922e0f4",
-	"feed_entries":        "0b97344b4045058b7154d0c01b85e4afd957c23e7cb2d011451f96baf6233dfc",
-	"feeds":               "31acc253c547a6cce5710d72a6f6b3b396162ecd5e5af295b2cf47c1ff55bd06",
+	"edit_feed":           "7b2c3e9d01fc45339f7d3a48a3ca5e2a6b6d92424c624915becb34e18c468f69",
+	"edit_rule":           "b9541eedfbc613f87eee00c0d01b0d9339f3dded3ded38afb1e1c223b63c5203",
+	"edit_site_rule":      "e558c358492099c2c82859b38017fb3880fc67559a04ca87477d36984a968aca",
+	"edit_user":           "947a8791f1be6ab514f8fd071fbafb40ee4d72af4ff04080df6150dd04c4b6eb",
+	"entry":               "21ad03c93eb81649b08d7976316c6da46a8efce842db4e86ad4d0a8f5dbda061",
+	"entry_revisions":     "c64c626e0d1df8345287ed366e0ff83f16355c14559ea11817f759e5394bf846",
+	"feed_entries":        "685f263ebb9fc0f97074c1ed3dfb4a525e3fbf6f40976e06883334764c79721a",
+	"feeds":               "4049e2bc7edc61859a3cc7c8f64b851cb15f660a30fb5daa90f66a4fc74a5467",
 	"history_entries":     "b65ca1d85615caa7c314a33f1cb997aa3477a79e66b9894b2fd387271ad467d2",
 	"import":              "8349e47a783bb40d8e9248b4771656e5f006185e11079e1c4680dd52633420ed",
-	"integrations":        "f85b4a48ab1fc13b8ca94bfbbc44bd5e8784f35b26a63ec32cbe82b96b45e008",
+	"integrations":        "1faaedb86e4d5b88f0d0cf4c9ffdc8d715d37420f7173e21c700e7440ff882f8",
 	"login":               "2e72d2d4b9786641b696bedbed5e10b04bdfd68254ddbbdb0a53cca621d200c7",
+	"rules":               "a116b0b1d130580a204d53baf445523852c2811966e19cf44b0b4197fce80fd4",
 	"search_entries":      "d71849a4f2b0573c7c76ad0ea941812009e9f022de60895987a781d3e6f08a01",
-	"sessions":            "1b3ec0970a4111b81f86d6ed187bb410f88972e2ede6723b9febcc4c7e5fc921",
-	"settings":            "152143e58d057ea6ab3bfd8dd947bfd70685843ca40e40542484b23849746df4",
-	"unread_entries":      "880018cbc59ec09b23dd800c4010fadad944d7023e0d36a3872c09b5d4952799",
-	"users":               "4b56cc76fbcc424e7c870d0efca93bb44dbfcc2a08b685cf799c773fbb8dfb2f",
+	"sessions":            "91414e0fe8d8f5ab0d974ba6b08ae19465bb7b5d37cff23e007a9b837c4083d7",
+	"settings":            "213cba4fe037a7ae416708e04c005704cd2af199fee9b985c3d0a36e051119ae",
+	"site_rules":          "3b15927d9a94acae84b21bbf75d21d8a550436522277d6279807ee74ede60770",
+	"tag_entries":         "43d57d9c99c681d5d6f1aba303dcf57635b3d7387425cba3c42031f84fbc28a9",
+	"tags":                "23e97865a10b2d6f98973a02ba1dcdf59d32c653d7f21ad271d1ab49bae058ed",
+	"unread_entries":      "263fc3906a039a891c6776f5a41383623addf79e25a71848702aa9b87d255c57",
+	"users":               "67e504a537bbcd17bda27c75a6efd8444fca81a230f1ed6649f2a0e13a0b4c97",
 }
@@ -167,6 +167,56 @@ func TestGetEntry(t *testing.T) {
 	}
 }
 
+func TestGetEntryRevisions(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revisions, err := client.EntryRevisions(result.Entries[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revisions) != 0 {
+		t.Fatalf(`A new entry should not have any revisions, got %d`, len(revisions))
+	}
+
+	if result.Entries[0].UpdatedAt != nil {
+		t.Fatal(`A new entry should not have an update date`)
+	}
+}
+
+func TestGetRevisionsOfUnknownEntry(t *testing.T) {
+	client := createClient(t)
+
+	_, err := client.EntryRevisions(123456789)
+	if err == nil {
+		t.Fatal(`Getting the revisions of an unknown entry should raise an error`)
+	}
+}
+
+func TestGetUnknownEnclosure(t *testing.T) {
+	client := createClient(t)
+
+	_, err := client.Enclosure(123456789)
+	if err == nil {
+		t.Fatal(`Getting an unknown enclosure should raise an error`)
+	}
+}
+
+func TestUpdateUnknownEnclosure(t *testing.T) {
+	client := createClient(t)
+
+	_, err := client.UpdateEnclosure(123456789, 42)
+	if err == nil {
+		t.Fatal(`Updating an unknown enclosure should raise an error`)
+	}
+}
+
 func TestUpdateStatus(t *testing.T) {
 	client := createClient(t)
 	createFeed(t, client)
@@ -223,3 +273,116 @@ func TestToggleBookmark(t *testing.T) {
 		t.Fatal("The entry should be starred")
 	}
 }
+
+func TestUpdateEntryTags(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	tags, err := client.UpdateEntryTags(entryID, []string{"reading list", " ", "Go", "go"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tags) != 2 || tags[0].Title != "Go" || tags[1].Title != "reading list" {
+		t.Fatalf(`Invalid tags, got %v`, tags)
+	}
+
+	tags, err = client.UpdateEntryTags(entryID, []string{"Go"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tags) != 1 || tags[0].Title != "Go" {
+		t.Fatalf(`The other tags should be removed, got %v`, tags)
+	}
+
+	userTags, err := client.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(userTags) != 1 || userTags[0].Title != "Go" || userTags[0].EntryCount != 1 {
+		t.Fatalf(`Invalid list of tags, got %v`, userTags)
+	}
+}
+
+func TestFilterEntriesByTag(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.UpdateEntryTags(result.Entries[0].ID, []string{"saved"}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = client.Entries(&miniflux.Filter{Tag: "saved"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != 1 || len(result.Entries[0].Tags) != 1 || result.Entries[0].Tags[0].Title != "saved" {
+		t.Fatalf(`Only the tagged entry should be returned, got %d entries`, result.Total)
+	}
+
+	result, err = client.Entries(&miniflux.Filter{Tag: "unknown"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != 0 {
+		t.Fatalf(`No entry should have an unknown tag, got %d entries`, result.Total)
+	}
+}
+
+func TestFilterEntriesByReadingTime(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Order: "reading_time", Direction: "desc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 {
+		t.Fatal(`The feed should have entries`)
+	}
+
+	longest := result.Entries[0].ReadingTime
+	if longest == 0 {
+		t.Fatal(`The reading time should be estimated`)
+	}
+
+	for _, entry := range result.Entries {
+		if entry.ReadingTime > longest {
+			t.Fatalf(`The entries should be sorted by reading time, got %d after %d`, entry.ReadingTime, longest)
+		}
+	}
+
+	result, err = client.Entries(&miniflux.Filter{MinReadingTime: longest + 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != 0 {
+		t.Fatalf(`No entry should take more than %d minutes to read, got %d entries`, longest, result.Total)
+	}
+
+	result, err = client.Entries(&miniflux.Filter{MaxReadingTime: longest})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 {
+		t.Fatalf(`The entries that take at most %d minutes to read should be returned`, longest)
+	}
+}
@@ -145,6 +145,142 @@ func TestUpdateFeedCrawler(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedMarkUnreadOnUpdate(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.MarkUnreadOnUpdate {
+		t.Fatal(`Updated entries should not be marked as unread by default`)
+	}
+
+	markUnreadOnUpdate := true
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{MarkUnreadOnUpdate: &markUnreadOnUpdate})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.MarkUnreadOnUpdate != markUnreadOnUpdate {
+		t.Fatalf(`Wrong mark_unread_on_update value, got "%v" instead of "%v"`, updatedFeed.MarkUnreadOnUpdate, markUnreadOnUpdate)
+	}
+}
+
+func TestUpdateFeedKeepTrackingParameters(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.KeepTrackingParameters {
+		t.Fatal(`The tracking parameters should be removed by default`)
+	}
+
+	keepTrackingParameters := true
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{KeepTrackingParameters: &keepTrackingParameters})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.KeepTrackingParameters != keepTrackingParameters {
+		t.Fatalf(`Wrong keep_tracking_parameters value, got "%v" instead of "%v"`, updatedFeed.KeepTrackingParameters, keepTrackingParameters)
+	}
+}
+
+func TestUpdateFeedResolveFinalURL(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.ResolveFinalURL {
+		t.Fatal(`The entry URLs should not be resolved by default`)
+	}
+
+	resolveFinalURL := true
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{ResolveFinalURL: &resolveFinalURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ResolveFinalURL != resolveFinalURL {
+		t.Fatalf(`Wrong resolve_final_url value, got "%v" instead of "%v"`, updatedFeed.ResolveFinalURL, resolveFinalURL)
+	}
+}
+
+func TestUpdateFeedDisabled(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	disabled := true
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{Disabled: &disabled})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Disabled != disabled {
+		t.Fatalf(`Wrong disabled value, got "%v" instead of "%v"`, updatedFeed.Disabled, disabled)
+	}
+
+	disabled = false
+	updatedFeed, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{Disabled: &disabled})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Disabled != disabled {
+		t.Fatalf(`Wrong disabled value, got "%v" instead of "%v"`, updatedFeed.Disabled, disabled)
+	}
+}
+
+func TestUpdateFeedProxyURL(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	proxyURL := "socks5://127.0.0.1:9050"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{ProxyURL: &proxyURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ProxyURL != proxyURL {
+		t.Fatalf(`Wrong proxy URL, got %q instead of %q`, updatedFeed.ProxyURL, proxyURL)
+	}
+
+	invalidProxyURL := "ftp://127.0.0.1"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{ProxyURL: &invalidProxyURL}); err == nil {
+		t.Fatal(`Unsupported proxy URLs should be rejected`)
+	}
+}
+
+func TestGetFeedHistory(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if err := client.RefreshFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	fetches, err := client.FeedHistory(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fetches) == 0 {
+		t.Fatal(`The refresh should be recorded in the history`)
+	}
+
+	if fetches[0].FeedID != feed.ID {
+		t.Errorf(`Unexpected feed ID, got %d instead of %d`, fetches[0].FeedID, feed.ID)
+	}
+
+	if fetches[0].StatusCode != 200 {
+		t.Errorf(`Unexpected status code, got %d`, fetches[0].StatusCode)
+	}
+}
+
+func TestGetHistoryOfUnknownFeed(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.FeedHistory(123456789); err != miniflux.ErrNotFound {
+		t.Errorf(`A not found error should be returned, got %v`, err)
+	}
+}
+
 func TestUpdateFeedScraperRules(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -195,6 +331,54 @@ func TestUpdateFeedRewriteRules(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedFilterRules(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.FilterAction != "drop" {
+		t.Fatalf(`Wrong default FilterAction value, got "%v" instead of "drop"`, feed.FilterAction)
+	}
+
+	keepRules := "title:(?i)golang"
+	blockRules := "category:Sponsored\nauthor:^Bot$"
+	filterAction := "read"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{
+		KeepRules:    &keepRules,
+		BlockRules:   &blockRules,
+		FilterAction: &filterAction,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.KeepRules != keepRules {
+		t.Fatalf(`Wrong KeepRules value, got "%v" instead of "%v"`, updatedFeed.KeepRules, keepRules)
+	}
+
+	if updatedFeed.BlockRules != blockRules {
+		t.Fatalf(`Wrong BlockRules value, got "%v" instead of "%v"`, updatedFeed.BlockRules, blockRules)
+	}
+
+	if updatedFeed.FilterAction != filterAction {
+		t.Fatalf(`Wrong FilterAction value, got "%v" instead of "%v"`, updatedFeed.FilterAction, filterAction)
+	}
+}
+
+func TestUpdateFeedWithInvalidFilterRules(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	blockRules := "title:(unclosed"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{BlockRules: &blockRules}); err == nil {
+		t.Fatal(`Invalid filter rules should be rejected`)
+	}
+
+	filterAction := "delete"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{FilterAction: &filterAction}); err == nil {
+		t.Fatal(`Invalid filter action should be rejected`)
+	}
+}
+
 func TestUpdateFeedUserAgent(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
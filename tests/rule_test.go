@@ -0,0 +1,133 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateRule(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	rule, err := client.CreateRule(&miniflux.Rule{Title: "Star everything", FeedID: feed.ID, Star: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rule.ID == 0 {
+		t.Fatalf(`Invalid ruleID, got "%v"`, rule.ID)
+	}
+
+	if rule.FeedID != feed.ID {
+		t.Fatalf(`Invalid feedID, got "%v" instead of "%v"`, rule.FeedID, feed.ID)
+	}
+
+	rules, err := client.Rules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 1 || rules[0].ID != rule.ID {
+		t.Fatalf(`Invalid list of rules, got %v`, rules)
+	}
+}
+
+func TestCreateInvalidRule(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.CreateRule(&miniflux.Rule{Title: "No action", TitlePattern: "golang"}); err == nil {
+		t.Fatal(`A rule without action should be rejected`)
+	}
+
+	if _, err := client.CreateRule(&miniflux.Rule{Title: "Invalid", TitlePattern: "(golang", Star: true}); err == nil {
+		t.Fatal(`A rule with an invalid regular expression should be rejected`)
+	}
+
+	if _, err := client.CreateRule(&miniflux.Rule{Title: "Unknown feed", FeedID: 123456, Star: true}); err == nil {
+		t.Fatal(`A rule with an unknown feed should be rejected`)
+	}
+}
+
+func TestUpdateRule(t *testing.T) {
+	client := createClient(t)
+	_, category := createFeed(t, client)
+
+	rule, err := client.CreateRule(&miniflux.Rule{Title: "Tag", CategoryID: category.ID, Tag: "news"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rule.Title = "Mark as read"
+	rule.Tag = ""
+	rule.MarkAsRead = true
+	updatedRule, err := client.UpdateRule(rule.ID, rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedRule.Title != rule.Title || !updatedRule.MarkAsRead || updatedRule.Tag != "" {
+		t.Fatalf(`The rule has not been updated, got %+v`, updatedRule)
+	}
+}
+
+func TestApplyRule(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	rule, err := client.CreateRule(&miniflux.Rule{Title: "Star feed", FeedID: feed.ID, Star: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	matched, err := client.ApplyRule(rule.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if matched == 0 {
+		t.Fatal(`The rule should match the entries of the feed`)
+	}
+
+	results, err := client.Entries(&miniflux.Filter{Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != matched {
+		t.Fatalf(`Invalid number of starred entries, got %d instead of %d`, results.Total, matched)
+	}
+
+	rule, err = client.Rule(rule.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rule.MatchCount != matched {
+		t.Fatalf(`Invalid match count, got %d instead of %d`, rule.MatchCount, matched)
+	}
+}
+
+func TestDeleteRule(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	rule, err := client.CreateRule(&miniflux.Rule{Title: "Read", FeedID: feed.ID, MarkAsRead: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteRule(rule.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Rule(rule.ID); err == nil {
+		t.Fatal(`The rule should be removed`)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateSiteRule(t *testing.T) {
+	client := createClient(t)
+
+	rule, err := client.CreateSiteRule(&miniflux.SiteRule{Domain: " Example.ORG ", ScraperRules: "article ! .share"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rule.ID == 0 || rule.Global || rule.Domain != "example.org" {
+		t.Fatalf(`Invalid site rule, got %+v`, rule)
+	}
+
+	rules, err := client.SiteRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, r := range rules {
+		if r.ID == rule.ID {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Fatalf(`The site rule should be listed, got %v`, rules)
+	}
+
+	if _, err := client.CreateSiteRule(&miniflux.SiteRule{Domain: "example.org"}); err == nil {
+		t.Fatal(`Two rules of the same user should not have the same domain`)
+	}
+
+	if _, err := client.CreateSiteRule(&miniflux.SiteRule{Domain: "https://example.org/"}); err == nil {
+		t.Fatal(`A rule with an URL instead of a domain should be rejected`)
+	}
+}
+
+func TestCreateGlobalSiteRuleAsRegularUser(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.CreateSiteRule(&miniflux.SiteRule{Domain: "example.org", Global: true}); err == nil {
+		t.Fatal(`Regular users should not be able to create global rules`)
+	}
+}
+
+func TestCreateGlobalSiteRule(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	domain := getRandomUsername() + ".example.org"
+
+	rule, err := client.CreateSiteRule(&miniflux.SiteRule{Domain: domain, Global: true, Disabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !rule.Global || rule.UserID != 0 {
+		t.Fatalf(`The site rule should be global, got %+v`, rule)
+	}
+
+	userClient := createClient(t)
+	if _, err := userClient.SiteRule(rule.ID); err != nil {
+		t.Fatalf(`Global rules should be visible by all users: %v`, err)
+	}
+
+	if err := userClient.DeleteSiteRule(rule.ID); err == nil {
+		t.Fatal(`Regular users should not be able to remove global rules`)
+	}
+
+	if err := client.DeleteSiteRule(rule.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateSiteRule(t *testing.T) {
+	client := createClient(t)
+
+	rule, err := client.CreateSiteRule(&miniflux.SiteRule{Domain: "example.org", ScraperRules: "article"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rule, err = client.UpdateSiteRule(rule.ID, &miniflux.SiteRule{Domain: "example.org", RewriteRules: "nl2br", Disabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rule.ScraperRules != "" || rule.RewriteRules != "nl2br" || !rule.Disabled {
+		t.Fatalf(`Invalid site rule, got %+v`, rule)
+	}
+}
+
+func TestRemoveSiteRule(t *testing.T) {
+	client := createClient(t)
+
+	rule, err := client.CreateSiteRule(&miniflux.SiteRule{Domain: "example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteSiteRule(rule.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.SiteRule(rule.ID); err == nil {
+		t.Fatal(`The site rule should be removed`)
+	}
+}
+
+func TestImportExportSiteRules(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.CreateSiteRule(&miniflux.SiteRule{Domain: "example.org", ScraperRules: "article"}); err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := client.ImportSiteRules(miniflux.SiteRules{
+		{Domain: "example.org", ScraperRules: "main"},
+		{Domain: "example.com", RewriteRules: "nl2br"},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if imported != 2 {
+		t.Fatalf(`Invalid number of imported rules, got %d`, imported)
+	}
+
+	rules, err := client.ExportSiteRules(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 2 {
+		t.Fatalf(`Invalid number of exported rules, got %d`, len(rules))
+	}
+
+	if rules[0].ID != 0 || rules[0].Domain != "example.com" || rules[1].Domain != "example.org" || rules[1].ScraperRules != "main" {
+		t.Fatalf(`Invalid exported rules, got %+v %+v`, rules[0], rules[1])
+	}
+
+	if _, err := client.ImportSiteRules(miniflux.SiteRules{{Domain: "example.net"}}, true); err == nil {
+		t.Fatal(`Regular users should not be able to import global rules`)
+	}
+}
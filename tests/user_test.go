@@ -270,6 +270,36 @@ func TestUpdateUserThemeWithInvalidValue(t *testing.T) {
 	}
 }
 
+func TestUpdateUserDuplicatePolicy(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.DuplicatePolicy != "none" || user.DuplicateMatchTitle {
+		t.Fatalf(`Duplicate entries should be kept by default: got %q`, user.DuplicatePolicy)
+	}
+
+	policy := "group"
+	matchTitle := true
+	user, err = client.UpdateUser(user.ID, &miniflux.UserModification{DuplicatePolicy: &policy, DuplicateMatchTitle: &matchTitle})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.DuplicatePolicy != policy || !user.DuplicateMatchTitle {
+		t.Fatalf(`Unable to update user duplicate policy: got %q instead of %q`, user.DuplicatePolicy, policy)
+	}
+
+	policy = "invalid"
+	_, err = client.UpdateUser(user.ID, &miniflux.UserModification{DuplicatePolicy: &policy})
+	if err == nil {
+		t.Fatal(`Updating a user duplicate policy with an invalid value should raise an error`)
+	}
+}
+
 func TestCannotCreateDuplicateUser(t *testing.T) {
 	username := getRandomUsername()
 	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
@@ -378,3 +408,26 @@ func TestCannotDeleteUserAsNonAdmin(t *testing.T) {
 		t.Fatal(`A "Forbidden" error should be raised`)
 	}
 }
+
+func TestUpdateUserMarkReadOnMediaCompletion(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.MarkReadOnMediaCompletion {
+		t.Fatal(`The entries should be marked as read when opened by default`)
+	}
+
+	value := true
+	user, err = client.UpdateUser(user.ID, &miniflux.UserModification{MarkReadOnMediaCompletion: &value})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !user.MarkReadOnMediaCompletion {
+		t.Fatal(`Unable to enable the mark as read on media completion setting`)
+	}
+}
@@ -42,6 +42,7 @@ func (h *handler) showCategoryEntriesAllPage(w http.ResponseWriter, r *http.Requ
 	builder.WithoutStatus(model.EntryStatusRemoved)
 	builder.WithOffset(offset)
 	builder.WithLimit(nbItemsPerPage)
+	readingTime := withReadingTimeFilter(r, builder)
 
 	entries, err := builder.GetEntries()
 	if err != nil {
@@ -60,7 +61,10 @@ func (h *handler) showCategoryEntriesAllPage(w http.ResponseWriter, r *http.Requ
 	view.Set("category", category)
 	view.Set("total", count)
 	view.Set("entries", entries)
-	view.Set("pagination", getPagination(route.Path(h.router, "categoryEntriesAll", "categoryID", category.ID), count, offset))
+	pagination := getPagination(route.Path(h.router, "categoryEntriesAll", "categoryID", category.ID), count, offset)
+	pagination.ReadingTime = readingTime
+	view.Set("pagination", pagination)
+	view.Set("readingTime", readingTime)
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
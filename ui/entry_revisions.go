@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/diff"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+// entryRevisionChanges represents the changes made to an entry when a revision was replaced.
+type entryRevisionChanges struct {
+	Revision *model.EntryRevision
+	Title    diff.Changes
+	Content  diff.Changes
+}
+
+func (h *handler) showEntryRevisionsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	revisions, err := h.store.EntryRevisions(user.ID, entry.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	// Each revision is compared to the version that replaced it.
+	var changes []*entryRevisionChanges
+	title, content := entry.Title, entry.Content
+	for _, revision := range revisions {
+		changes = append(changes, &entryRevisionChanges{
+			Revision: revision,
+			Title:    diff.Words(revision.Title, title),
+			Content:  diff.Words(sanitizer.StripTags(revision.Content), sanitizer.StripTags(content)),
+		})
+
+		title, content = revision.Title, revision.Content
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("entry", entry)
+	view.Set("changes", changes)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("entry_revisions"))
+}
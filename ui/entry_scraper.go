@@ -30,7 +30,18 @@ func (h *handler) fetchContent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := processor.ProcessEntryWebPage(entry); err != nil {
+	feed, err := h.store.FeedByID(entry.UserID, entry.FeedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := processor.ProcessEntryWebPage(h.store, feed, entry); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
@@ -40,17 +40,33 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fetches, err := h.store.FeedFetches(user.ID, feed.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	feedForm := form.FeedForm{
-		SiteURL:      feed.SiteURL,
-		FeedURL:      feed.FeedURL,
-		Title:        feed.Title,
-		ScraperRules: feed.ScraperRules,
-		RewriteRules: feed.RewriteRules,
-		Crawler:      feed.Crawler,
-		UserAgent:    feed.UserAgent,
-		CategoryID:   feed.Category.ID,
-		Username:     feed.Username,
-		Password:     feed.Password,
+		SiteURL:                feed.SiteURL,
+		FeedURL:                feed.FeedURL,
+		Title:                  feed.Title,
+		ScraperRules:           feed.ScraperRules,
+		RewriteRules:           feed.RewriteRules,
+		KeepRules:              feed.KeepRules,
+		BlockRules:             feed.BlockRules,
+		FilterAction:           feed.FilterAction,
+		Crawler:                feed.Crawler,
+		MarkUnreadOnUpdate:     feed.MarkUnreadOnUpdate,
+		KeepTrackingParameters: feed.KeepTrackingParameters,
+		ResolveFinalURL:        feed.ResolveFinalURL,
+		Disabled:               feed.Disabled,
+		UserAgent:              feed.UserAgent,
+		ProxyURL:               feed.ProxyURL,
+		Headers:                form.FormatRequestHeaders(feed.Headers),
+		Cookie:                 feed.Cookie,
+		CategoryID:             feed.Category.ID,
+		Username:               feed.Username,
+		Password:               feed.Password,
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -58,6 +74,7 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
 	view.Set("feed", feed)
+	view.Set("fetches", fetches)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -42,6 +42,7 @@ func (h *handler) showFeedEntriesPage(w http.ResponseWriter, r *http.Request) {
 	builder.WithDirection(user.EntryDirection)
 	builder.WithOffset(offset)
 	builder.WithLimit(nbItemsPerPage)
+	readingTime := withReadingTimeFilter(r, builder)
 
 	entries, err := builder.GetEntries()
 	if err != nil {
@@ -60,7 +61,10 @@ func (h *handler) showFeedEntriesPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("feed", feed)
 	view.Set("entries", entries)
 	view.Set("total", count)
-	view.Set("pagination", getPagination(route.Path(h.router, "feedEntries", "feedID", feed.ID), count, offset))
+	pagination := getPagination(route.Path(h.router, "feedEntries", "feedID", feed.ID), count, offset)
+	pagination.ReadingTime = readingTime
+	view.Set("pagination", pagination)
+	view.Set("readingTime", readingTime)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -13,8 +13,24 @@ import (
 )
 
 func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
 	feedID := request.RouteInt64Param(r, "feedID")
-	if err := h.store.RemoveFeed(request.UserID(r), feedID); err != nil {
+
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	// The hub may still verify the request after the removal, it is a best effort.
+	h.feedHandler.UnsubscribeFromHub(feed)
+
+	if err := h.store.RemoveFeed(userID, feedID); err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
@@ -42,6 +42,12 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	fetches, err := h.store.FeedFetches(user.ID, feed.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	feedForm := form.NewFeedForm(r)
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -49,6 +55,7 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
 	view.Set("feed", feed)
+	view.Set("fetches", fetches)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -61,6 +68,7 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	wasDisabled := feed.Disabled
 	err = h.store.UpdateFeed(feedForm.Merge(feed))
 	if err != nil {
 		logger.Error("[UI:UpdateFeed] %v", err)
@@ -69,5 +77,9 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if feed.Disabled && !wasDisabled {
+		h.feedHandler.UnsubscribeFromHub(feed)
+	}
+
 	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
 }
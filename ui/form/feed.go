@@ -5,25 +5,41 @@
 package form // import "miniflux.app/ui/form"
 
 import (
+	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 
+	"miniflux.app/config"
 	"miniflux.app/errors"
+	"miniflux.app/http/client"
 	"miniflux.app/model"
+	"miniflux.app/reader/filter"
 )
 
 // FeedForm represents a feed form in the UI
 type FeedForm struct {
-	FeedURL      string
-	SiteURL      string
-	Title        string
-	ScraperRules string
-	RewriteRules string
-	Crawler      bool
-	UserAgent    string
-	CategoryID   int64
-	Username     string
-	Password     string
+	FeedURL                string
+	SiteURL                string
+	Title                  string
+	ScraperRules           string
+	RewriteRules           string
+	KeepRules              string
+	BlockRules             string
+	FilterAction           string
+	Crawler                bool
+	MarkUnreadOnUpdate     bool
+	KeepTrackingParameters bool
+	ResolveFinalURL        bool
+	Disabled               bool
+	UserAgent              string
+	ProxyURL               string
+	Headers                string
+	Cookie                 string
+	CategoryID             int64
+	Username               string
+	Password               string
 }
 
 // ValidateModification validates FeedForm fields
@@ -31,7 +47,18 @@ func (f FeedForm) ValidateModification() error {
 	if f.FeedURL == "" || f.SiteURL == "" || f.Title == "" || f.CategoryID == 0 {
 		return errors.NewLocalizedError("error.fields_mandatory")
 	}
-	return nil
+
+	if f.ProxyURL != "" {
+		if err := client.ValidateProxyURL(f.ProxyURL); err != nil {
+			return errors.NewLocalizedError("error.invalid_proxy_url")
+		}
+	}
+
+	if err := validateFilterRules(f.KeepRules, f.BlockRules, f.FilterAction); err != nil {
+		return err
+	}
+
+	return validateRequestHeaders(f.Headers, f.Cookie)
 }
 
 // Merge updates the fields of the given feed.
@@ -42,8 +69,25 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.FeedURL = f.FeedURL
 	feed.ScraperRules = f.ScraperRules
 	feed.RewriteRules = f.RewriteRules
+	feed.KeepRules = f.KeepRules
+	feed.BlockRules = f.BlockRules
+	feed.FilterAction = f.FilterAction
+	if feed.FilterAction == "" {
+		feed.FilterAction = model.FilterActionDrop
+	}
 	feed.Crawler = f.Crawler
+	feed.MarkUnreadOnUpdate = f.MarkUnreadOnUpdate
+	feed.KeepTrackingParameters = f.KeepTrackingParameters
+	feed.ResolveFinalURL = f.ResolveFinalURL
+	feed.Disabled = f.Disabled
 	feed.UserAgent = f.UserAgent
+	feed.ProxyURL = f.ProxyURL
+	feed.Headers, _ = ParseRequestHeaders(f.Headers)
+	feed.Cookie = f.Cookie
+
+	// The form always contains the headers and the cookie, the values that could not be decrypted are replaced.
+	feed.EncryptedHeaders = ""
+	feed.EncryptedCookie = ""
 	feed.ParsingErrorCount = 0
 	feed.ParsingErrorMsg = ""
 	feed.Username = f.Username
@@ -59,15 +103,99 @@ func NewFeedForm(r *http.Request) *FeedForm {
 	}
 
 	return &FeedForm{
-		FeedURL:      r.FormValue("feed_url"),
-		SiteURL:      r.FormValue("site_url"),
-		Title:        r.FormValue("title"),
-		ScraperRules: r.FormValue("scraper_rules"),
-		UserAgent:    r.FormValue("user_agent"),
-		RewriteRules: r.FormValue("rewrite_rules"),
-		Crawler:      r.FormValue("crawler") == "1",
-		CategoryID:   int64(categoryID),
-		Username:     r.FormValue("feed_username"),
-		Password:     r.FormValue("feed_password"),
+		FeedURL:                r.FormValue("feed_url"),
+		SiteURL:                r.FormValue("site_url"),
+		Title:                  r.FormValue("title"),
+		ScraperRules:           r.FormValue("scraper_rules"),
+		UserAgent:              r.FormValue("user_agent"),
+		ProxyURL:               r.FormValue("proxy_url"),
+		Headers:                r.FormValue("headers"),
+		Cookie:                 r.FormValue("cookie"),
+		RewriteRules:           r.FormValue("rewrite_rules"),
+		KeepRules:              r.FormValue("keep_rules"),
+		BlockRules:             r.FormValue("block_rules"),
+		FilterAction:           r.FormValue("filter_action"),
+		Crawler:                r.FormValue("crawler") == "1",
+		MarkUnreadOnUpdate:     r.FormValue("mark_unread_on_update") == "1",
+		KeepTrackingParameters: r.FormValue("keep_tracking_parameters") == "1",
+		ResolveFinalURL:        r.FormValue("resolve_final_url") == "1",
+		Disabled:               r.FormValue("disabled") == "1",
+		CategoryID:             int64(categoryID),
+		Username:               r.FormValue("feed_username"),
+		Password:               r.FormValue("feed_password"),
 	}
 }
+
+// ParseRequestHeaders converts the custom headers entered in the form, one "Name: Value" per line.
+func ParseRequestHeaders(text string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("form: invalid header line %q", line)
+		}
+
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	return headers, nil
+}
+
+// FormatRequestHeaders converts custom headers to the text displayed in the form.
+func FormatRequestHeaders(headers map[string]string) string {
+	var lines []string
+	for name, value := range headers {
+		lines = append(lines, name+": "+value)
+	}
+
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+func validateRequestHeaders(text, cookie string) error {
+	headers, err := ParseRequestHeaders(text)
+	if err != nil {
+		return errors.NewLocalizedError("error.invalid_headers")
+	}
+
+	if len(headers) == 0 && cookie == "" {
+		return nil
+	}
+
+	if !config.Opts.HasEncryptionKey() {
+		return errors.NewLocalizedError("error.encryption_key_missing")
+	}
+
+	if err := client.ValidateHeaders(headers, cookie); err != nil {
+		return errors.NewLocalizedError("error.invalid_headers")
+	}
+
+	return nil
+}
+
+func validateFilterRules(keepRules, blockRules, action string) error {
+	if _, err := filter.ParseRules(keepRules); err != nil {
+		return errors.NewLocalizedError("error.invalid_filter_rules")
+	}
+
+	if _, err := filter.ParseRules(blockRules); err != nil {
+		return errors.NewLocalizedError("error.invalid_filter_rules")
+	}
+
+	if action != "" {
+		if err := model.ValidateFilterAction(action); err != nil {
+			return errors.NewLocalizedError("error.invalid_filter_rules")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package form // import "miniflux.app/ui/form"
+
+import (
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+func TestFeedFormWithProxy(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	feed := &FeedForm{
+		FeedURL:    "http://example.onion/feed.xml",
+		SiteURL:    "http://example.onion/",
+		Title:      "Example",
+		CategoryID: 1,
+		ProxyURL:   "socks5://127.0.0.1:9050",
+	}
+
+	if err := feed.ValidateModification(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFeedFormWithInvalidProxy(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	feed := &FeedForm{
+		FeedURL:    "http://example.org/feed.xml",
+		SiteURL:    "http://example.org/",
+		Title:      "Example",
+		CategoryID: 1,
+		ProxyURL:   "ftp://127.0.0.1",
+	}
+
+	if err := feed.ValidateModification(); err == nil {
+		t.Error(`An unsupported proxy scheme should be rejected`)
+	}
+}
+
+func TestFeedFormWithInvalidFilterRules(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	feed := &FeedForm{
+		FeedURL:    "http://example.org/feed.xml",
+		SiteURL:    "http://example.org/",
+		Title:      "Example",
+		CategoryID: 1,
+		BlockRules: "title:[a-z",
+	}
+
+	if err := feed.ValidateModification(); err == nil {
+		t.Error(`Invalid filter rules should be rejected`)
+	}
+}
+
+func TestParseRequestHeaders(t *testing.T) {
+	headers, err := ParseRequestHeaders("Authorization: Bearer token\r\n\r\n X-Api-Key:key:with:colons \n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(headers) != 2 || headers["Authorization"] != "Bearer token" || headers["X-Api-Key"] != "key:with:colons" {
+		t.Errorf(`Unexpected headers: %v`, headers)
+	}
+
+	if _, err := ParseRequestHeaders("not a header"); err == nil {
+		t.Error(`A line without separator should be rejected`)
+	}
+}
+
+func TestFormatRequestHeaders(t *testing.T) {
+	text := FormatRequestHeaders(map[string]string{"X-Api-Key": "key", "Authorization": "Bearer token"})
+	if text != "Authorization: Bearer token\nX-Api-Key: key" {
+		t.Errorf(`Unexpected text, got %q`, text)
+	}
+}
+
+func TestFeedFormWithHeadersRequiresEncryptionKey(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	feed := &FeedForm{
+		FeedURL:    "http://example.org/feed.xml",
+		SiteURL:    "http://example.org/",
+		Title:      "Example",
+		CategoryID: 1,
+		Cookie:     "session=abc",
+	}
+
+	if err := feed.ValidateModification(); err == nil {
+		t.Error(`Custom headers should be rejected without encryption key`)
+	}
+}
+
+func TestFeedFormMergeRemovesUndecryptableHeaders(t *testing.T) {
+	form := &FeedForm{CategoryID: 1}
+	feed := form.Merge(&model.Feed{Category: &model.Category{}, EncryptedHeaders: "old headers", EncryptedCookie: "old cookie"})
+
+	if feed.EncryptedHeaders != "" || feed.EncryptedCookie != "" {
+		t.Error(`Saving the form should remove the values that could not be decrypted`)
+	}
+}
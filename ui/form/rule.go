@@ -0,0 +1,123 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// RuleForm represents an automation rule form in the UI.
+type RuleForm struct {
+	Title              string
+	FeedID             int64
+	CategoryID         int64
+	TitlePattern       string
+	ContentPattern     string
+	AuthorPattern      string
+	OlderThan          int
+	MarkAsRead         bool
+	Star               bool
+	SendToIntegrations bool
+	Tag                string
+}
+
+// Validate makes sure the form values are valid.
+func (r RuleForm) Validate() error {
+	if r.Title == "" {
+		return errors.NewLocalizedError("error.title_required")
+	}
+
+	rule := r.Merge(&model.Rule{})
+	if !rule.HasCondition() {
+		return errors.NewLocalizedError("error.rule_condition_required")
+	}
+
+	if !rule.HasAction() {
+		return errors.NewLocalizedError("error.rule_action_required")
+	}
+
+	if r.OlderThan < 0 {
+		return errors.NewLocalizedError("error.invalid_rule_age")
+	}
+
+	for _, pattern := range []string{r.TitlePattern, r.ContentPattern, r.AuthorPattern} {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return errors.NewLocalizedError("error.invalid_rule_pattern", pattern)
+		}
+	}
+
+	return nil
+}
+
+// Merge updates the fields of the given rule.
+func (r RuleForm) Merge(rule *model.Rule) *model.Rule {
+	rule.Title = r.Title
+	rule.FeedID = r.FeedID
+	rule.CategoryID = r.CategoryID
+	rule.TitlePattern = r.TitlePattern
+	rule.ContentPattern = r.ContentPattern
+	rule.AuthorPattern = r.AuthorPattern
+	rule.OlderThan = r.OlderThan
+	rule.MarkAsRead = r.MarkAsRead
+	rule.Star = r.Star
+	rule.SendToIntegrations = r.SendToIntegrations
+	rule.Tag = r.Tag
+	return rule
+}
+
+// NewRuleForm returns a new RuleForm.
+func NewRuleForm(r *http.Request) *RuleForm {
+	feedID, err := strconv.Atoi(r.FormValue("feed_id"))
+	if err != nil {
+		feedID = 0
+	}
+
+	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
+	if err != nil {
+		categoryID = 0
+	}
+
+	olderThan, err := strconv.Atoi(r.FormValue("older_than"))
+	if err != nil {
+		olderThan = 0
+	}
+
+	return &RuleForm{
+		Title:              strings.TrimSpace(r.FormValue("title")),
+		FeedID:             int64(feedID),
+		CategoryID:         int64(categoryID),
+		TitlePattern:       r.FormValue("title_pattern"),
+		ContentPattern:     r.FormValue("content_pattern"),
+		AuthorPattern:      r.FormValue("author_pattern"),
+		OlderThan:          olderThan,
+		MarkAsRead:         r.FormValue("mark_as_read") == "1",
+		Star:               r.FormValue("star") == "1",
+		SendToIntegrations: r.FormValue("send_to_integrations") == "1",
+		Tag:                strings.TrimSpace(r.FormValue("tag")),
+	}
+}
+
+// NewRuleFormFromRule returns a RuleForm filled with the values of an existing rule.
+func NewRuleFormFromRule(rule *model.Rule) *RuleForm {
+	return &RuleForm{
+		Title:              rule.Title,
+		FeedID:             rule.FeedID,
+		CategoryID:         rule.CategoryID,
+		TitlePattern:       rule.TitlePattern,
+		ContentPattern:     rule.ContentPattern,
+		AuthorPattern:      rule.AuthorPattern,
+		OlderThan:          rule.OlderThan,
+		MarkAsRead:         rule.MarkAsRead,
+		Star:               rule.Star,
+		SendToIntegrations: rule.SendToIntegrations,
+		Tag:                rule.Tag,
+	}
+}
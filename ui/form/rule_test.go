@@ -0,0 +1,51 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestValidRuleForm(t *testing.T) {
+	ruleForm := &RuleForm{Title: "Golang", TitlePattern: "(?i)golang", Tag: "go"}
+	if err := ruleForm.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRuleFormRequiresConditionAndAction(t *testing.T) {
+	ruleForm := &RuleForm{Title: "Everything", Star: true}
+	if err := ruleForm.Validate(); err == nil {
+		t.Error(`A rule without condition should be rejected`)
+	}
+
+	ruleForm = &RuleForm{Title: "Nothing", CategoryID: 1}
+	if err := ruleForm.Validate(); err == nil {
+		t.Error(`A rule without action should be rejected`)
+	}
+}
+
+func TestRuleFormWithInvalidPattern(t *testing.T) {
+	ruleForm := &RuleForm{Title: "Invalid", ContentPattern: "(unclosed", MarkAsRead: true}
+	if err := ruleForm.Validate(); err == nil {
+		t.Error(`An invalid regular expression should be rejected`)
+	}
+}
+
+func TestRuleFormMerge(t *testing.T) {
+	rule := &model.Rule{ID: 1, UserID: 2, MatchCount: 3}
+	ruleForm := &RuleForm{Title: "Old", OlderThan: 30, MarkAsRead: true}
+	ruleForm.Merge(rule)
+
+	if rule.ID != 1 || rule.UserID != 2 || rule.MatchCount != 3 {
+		t.Error(`The identifiers and the counter should be kept`)
+	}
+
+	if rule.Title != "Old" || rule.OlderThan != 30 || !rule.MarkAsRead {
+		t.Errorf(`The form values should be merged, got %+v`, rule)
+	}
+}
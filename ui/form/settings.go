@@ -13,14 +13,17 @@ import (
 
 // SettingsForm represents the settings form.
 type SettingsForm struct {
-	Username          string
-	Password          string
-	Confirmation      string
-	Theme             string
-	Language          string
-	Timezone          string
-	EntryDirection    string
-	KeyboardShortcuts bool
+	Username                  string
+	Password                  string
+	Confirmation              string
+	Theme                     string
+	Language                  string
+	Timezone                  string
+	EntryDirection            string
+	KeyboardShortcuts         bool
+	DuplicatePolicy           string
+	DuplicateMatchTitle       bool
+	MarkReadOnMediaCompletion bool
 }
 
 // Merge updates the fields of the given user.
@@ -31,6 +34,9 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.Timezone = s.Timezone
 	user.EntryDirection = s.EntryDirection
 	user.KeyboardShortcuts = s.KeyboardShortcuts
+	user.DuplicatePolicy = s.DuplicatePolicy
+	user.DuplicateMatchTitle = s.DuplicateMatchTitle
+	user.MarkReadOnMediaCompletion = s.MarkReadOnMediaCompletion
 
 	if s.Password != "" {
 		user.Password = s.Password
@@ -45,6 +51,12 @@ func (s *SettingsForm) Validate() error {
 		return errors.NewLocalizedError("error.settings_mandatory_fields")
 	}
 
+	if s.DuplicatePolicy == "" {
+		s.DuplicatePolicy = model.DuplicatePolicyNone
+	} else if err := model.ValidateDuplicatePolicy(s.DuplicatePolicy); err != nil {
+		return errors.NewLocalizedError("error.invalid_duplicate_policy")
+	}
+
 	if s.Confirmation == "" {
 		// Firefox insists on auto-completing the password field.
 		// If the confirmation field is blank, the user probably
@@ -66,13 +78,16 @@ func (s *SettingsForm) Validate() error {
 // NewSettingsForm returns a new SettingsForm.
 func NewSettingsForm(r *http.Request) *SettingsForm {
 	return &SettingsForm{
-		Username:          r.FormValue("username"),
-		Password:          r.FormValue("password"),
-		Confirmation:      r.FormValue("confirmation"),
-		Theme:             r.FormValue("theme"),
-		Language:          r.FormValue("language"),
-		Timezone:          r.FormValue("timezone"),
-		EntryDirection:    r.FormValue("entry_direction"),
-		KeyboardShortcuts: r.FormValue("keyboard_shortcuts") == "1",
+		Username:                  r.FormValue("username"),
+		Password:                  r.FormValue("password"),
+		Confirmation:              r.FormValue("confirmation"),
+		Theme:                     r.FormValue("theme"),
+		Language:                  r.FormValue("language"),
+		Timezone:                  r.FormValue("timezone"),
+		EntryDirection:            r.FormValue("entry_direction"),
+		KeyboardShortcuts:         r.FormValue("keyboard_shortcuts") == "1",
+		DuplicatePolicy:           r.FormValue("duplicate_policy"),
+		DuplicateMatchTitle:       r.FormValue("duplicate_match_title") == "1",
+		MarkReadOnMediaCompletion: r.FormValue("mark_read_on_media_completion") == "1",
 	}
 }
@@ -58,3 +58,19 @@ func TestConfirmationIncorrect(t *testing.T) {
 		t.Error("Validate should return an error")
 	}
 }
+
+func TestInvalidDuplicatePolicy(t *testing.T) {
+	settings := &SettingsForm{
+		Username:        "user",
+		Theme:           "default",
+		Language:        "en_US",
+		Timezone:        "UTC",
+		EntryDirection:  "asc",
+		DuplicatePolicy: "invalid",
+	}
+
+	err := settings.Validate()
+	if err == nil {
+		t.Error("Validate should return an error")
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// SiteRuleForm represents a site rule form in the UI.
+type SiteRuleForm struct {
+	Domain       string
+	ScraperRules string
+	RewriteRules string
+	Disabled     bool
+	Global       bool
+}
+
+// Validate makes sure the form values are valid.
+func (s SiteRuleForm) Validate() error {
+	if s.Domain == "" {
+		return errors.NewLocalizedError("error.domain_required")
+	}
+
+	if strings.ContainsAny(s.Domain, " /:") {
+		return errors.NewLocalizedError("error.invalid_domain", s.Domain)
+	}
+
+	return nil
+}
+
+// Merge updates the fields of the given site rule, the owner of the rule is not changed.
+func (s SiteRuleForm) Merge(rule *model.SiteRule) *model.SiteRule {
+	rule.Domain = s.Domain
+	rule.ScraperRules = s.ScraperRules
+	rule.RewriteRules = s.RewriteRules
+	rule.Disabled = s.Disabled
+	return rule
+}
+
+// NewSiteRuleForm returns a new SiteRuleForm.
+func NewSiteRuleForm(r *http.Request) *SiteRuleForm {
+	return &SiteRuleForm{
+		Domain:       model.NormalizeDomain(r.FormValue("domain")),
+		ScraperRules: strings.TrimSpace(r.FormValue("scraper_rules")),
+		RewriteRules: strings.TrimSpace(r.FormValue("rewrite_rules")),
+		Disabled:     r.FormValue("disabled") == "1",
+		Global:       r.FormValue("global") == "1",
+	}
+}
+
+// NewSiteRuleFormFromSiteRule returns a SiteRuleForm filled with the values of an existing site rule.
+func NewSiteRuleFormFromSiteRule(rule *model.SiteRule) *SiteRuleForm {
+	return &SiteRuleForm{
+		Domain:       rule.Domain,
+		ScraperRules: rule.ScraperRules,
+		RewriteRules: rule.RewriteRules,
+		Disabled:     rule.Disabled,
+		Global:       rule.Global,
+	}
+}
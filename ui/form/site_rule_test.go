@@ -0,0 +1,37 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestValidateSiteRuleForm(t *testing.T) {
+	if err := (&SiteRuleForm{Domain: "example.org"}).Validate(); err != nil {
+		t.Error(err)
+	}
+
+	for _, domain := range []string{"", "https://example.org", "example.org/path"} {
+		if err := (&SiteRuleForm{Domain: domain}).Validate(); err == nil {
+			t.Errorf(`The domain %q should be rejected`, domain)
+		}
+	}
+}
+
+func TestSiteRuleFormMerge(t *testing.T) {
+	rule := &model.SiteRule{ID: 1, UserID: 2}
+	siteRuleForm := &SiteRuleForm{Domain: "example.org", ScraperRules: "article", RewriteRules: "nl2br", Disabled: true, Global: true}
+	siteRuleForm.Merge(rule)
+
+	if rule.ID != 1 || rule.UserID != 2 || rule.Global {
+		t.Errorf(`The owner of the rule should not be changed, got %+v`, rule)
+	}
+
+	if rule.Domain != "example.org" || rule.ScraperRules != "article" || rule.RewriteRules != "nl2br" || !rule.Disabled {
+		t.Errorf(`Unexpected merged rule, got %+v`, rule)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	"miniflux.app/errors"
+	"miniflux.app/http/client"
 )
 
 // SubscriptionForm represents the subscription form.
@@ -19,6 +20,9 @@ type SubscriptionForm struct {
 	UserAgent  string
 	Username   string
 	Password   string
+	ProxyURL   string
+	Headers    string
+	Cookie     string
 }
 
 // Validate makes sure the form values are valid.
@@ -27,7 +31,19 @@ func (s *SubscriptionForm) Validate() error {
 		return errors.NewLocalizedError("error.feed_mandatory_fields")
 	}
 
-	return nil
+	if s.ProxyURL != "" {
+		if err := client.ValidateProxyURL(s.ProxyURL); err != nil {
+			return errors.NewLocalizedError("error.invalid_proxy_url")
+		}
+	}
+
+	return validateRequestHeaders(s.Headers, s.Cookie)
+}
+
+// RequestHeaders returns the custom headers to send with the requests.
+func (s *SubscriptionForm) RequestHeaders() map[string]string {
+	headers, _ := ParseRequestHeaders(s.Headers)
+	return headers
 }
 
 // NewSubscriptionForm returns a new SubscriptionForm.
@@ -44,5 +60,8 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 		UserAgent:  r.FormValue("user_agent"),
 		Username:   r.FormValue("feed_username"),
 		Password:   r.FormValue("feed_password"),
+		ProxyURL:   r.FormValue("proxy_url"),
+		Headers:    r.FormValue("headers"),
+		Cookie:     r.FormValue("cookie"),
 	}
 }
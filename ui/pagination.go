@@ -18,6 +18,7 @@ type pagination struct {
 	NextOffset   int
 	PrevOffset   int
 	SearchQuery  string
+	ReadingTime  string
 }
 
 func getPagination(route string, total, offset int) pagination {
@@ -6,6 +6,7 @@ package ui  // import "miniflux.app/ui"
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 
@@ -31,3 +32,22 @@ func decodeEntryStatusPayload(r io.ReadCloser) (entryIDs []int64, status string,
 
 	return p.EntryIDs, p.Status, nil
 }
+
+func decodeEnclosureProgressionPayload(r io.ReadCloser) (int, error) {
+	type payload struct {
+		MediaProgression int `json:"media_progression"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return 0, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if p.MediaProgression < 0 {
+		return 0, errors.New("the media progression must be a positive number of seconds")
+	}
+
+	return p.MediaProgression, nil
+}
@@ -37,7 +37,19 @@ func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	feed, err := h.store.FeedByID(request.UserID(r), request.RouteInt64Param(r, "feedID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
 	clt := client.New(string(decodedURL))
+	clt.WithProxy(feed.ProxyURL)
 	resp, err := clt.Get()
 	if err != nil {
 		html.ServerError(w, r, err)
@@ -0,0 +1,29 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/storage"
+)
+
+const (
+	// readingTimeShort is the filter of the entries read in less than shortReadingTime minutes.
+	readingTimeShort = "short"
+	shortReadingTime = 5
+)
+
+// withReadingTimeFilter restricts the entries to the reading time given in the query string.
+// It returns the filter applied, or an empty string.
+func withReadingTimeFilter(r *http.Request, builder *storage.EntryQueryBuilder) string {
+	if request.QueryStringParam(r, "reading_time", "") != readingTimeShort {
+		return ""
+	}
+
+	builder.WithMaxReadingTime(shortReadingTime - 1)
+	return readingTimeShort
+}
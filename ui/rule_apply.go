@@ -0,0 +1,40 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/reader/rule"
+	"miniflux.app/ui/session"
+)
+
+func (h *handler) applyRule(w http.ResponseWriter, r *http.Request) {
+	userRule, err := h.store.RuleByID(request.UserID(r), request.RouteInt64Param(r, "ruleID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if userRule == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	matched, err := rule.ApplyRule(h.store, userRule)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess.NewFlashMessage(printer.Plural("alert.rule_applied", matched, matched))
+	html.Redirect(w, r, route.Path(h.router, "rules"))
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showCreateRulePage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	if err := h.setRuleChoices(view, user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	view.Set("form", &form.RuleForm{})
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("create_rule"))
+}
+
+// setRuleChoices adds the feeds and the categories selectable as conditions of a rule.
+func (h *handler) setRuleChoices(view *view.View, userID int64) error {
+	feeds, err := h.store.Feeds(userID)
+	if err != nil {
+		return err
+	}
+
+	categories, err := h.store.Categories(userID)
+	if err != nil {
+		return err
+	}
+
+	view.Set("feeds", feeds)
+	view.Set("categories", categories)
+	return nil
+}
+
+// ruleChoicesExist makes sure the feed and the category selected in the form belong to the user.
+func (h *handler) ruleChoicesExist(userID int64, ruleForm *form.RuleForm) bool {
+	if ruleForm.FeedID > 0 && !h.store.FeedExists(userID, ruleForm.FeedID) {
+		return false
+	}
+
+	return ruleForm.CategoryID == 0 || h.store.CategoryExists(userID, ruleForm.CategoryID)
+}
@@ -0,0 +1,63 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showRulesPage(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	rules, err := h.store.Rules(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feeds, err := h.store.Feeds(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feedTitles := make(map[int64]string)
+	for _, feed := range feeds {
+		feedTitles[feed.ID] = feed.Title
+	}
+
+	categoryTitles := make(map[int64]string)
+	for _, category := range categories {
+		categoryTitles[category.ID] = category.Title
+	}
+
+	view.Set("rules", rules)
+	view.Set("feedTitles", feedTitles)
+	view.Set("categoryTitles", categoryTitles)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("rules"))
+}
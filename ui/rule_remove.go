@@ -0,0 +1,34 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+)
+
+func (h *handler) removeRule(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	rule, err := h.store.RuleByID(userID, request.RouteInt64Param(r, "ruleID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveRule(userID, rule.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "rules"))
+}
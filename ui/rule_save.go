@@ -0,0 +1,63 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) saveRule(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	ruleForm := form.NewRuleForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	if err := h.setRuleChoices(view, user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	view.Set("form", ruleForm)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := ruleForm.Validate(); err != nil {
+		view.Set("errorMessage", err)
+		html.OK(w, r, view.Render("create_rule"))
+		return
+	}
+
+	if !h.ruleChoicesExist(user.ID, ruleForm) {
+		view.Set("errorMessage", "error.rule_condition_not_found")
+		html.OK(w, r, view.Render("create_rule"))
+		return
+	}
+
+	rule := ruleForm.Merge(&model.Rule{UserID: user.ID})
+	if err := h.store.CreateRule(rule); err != nil {
+		logger.Error("[UI:SaveRule] %v", err)
+		view.Set("errorMessage", "error.unable_to_create_rule")
+		html.OK(w, r, view.Render("create_rule"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "rules"))
+}
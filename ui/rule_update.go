@@ -0,0 +1,73 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) updateRule(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	rule, err := h.store.RuleByID(user.ID, request.RouteInt64Param(r, "ruleID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	ruleForm := form.NewRuleForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	if err := h.setRuleChoices(view, user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	view.Set("form", ruleForm)
+	view.Set("rule", rule)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := ruleForm.Validate(); err != nil {
+		view.Set("errorMessage", err)
+		html.OK(w, r, view.Render("edit_rule"))
+		return
+	}
+
+	if !h.ruleChoicesExist(user.ID, ruleForm) {
+		view.Set("errorMessage", "error.rule_condition_not_found")
+		html.OK(w, r, view.Render("edit_rule"))
+		return
+	}
+
+	if err := h.store.UpdateRule(ruleForm.Merge(rule)); err != nil {
+		logger.Error("[UI:UpdateRule] %v", err)
+		view.Set("errorMessage", "error.unable_to_update_rule")
+		html.OK(w, r, view.Render("edit_rule"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "rules"))
+}
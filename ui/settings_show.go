@@ -27,12 +27,15 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	settingsForm := form.SettingsForm{
-		Username:          user.Username,
-		Theme:             user.Theme,
-		Language:          user.Language,
-		Timezone:          user.Timezone,
-		EntryDirection:    user.EntryDirection,
-		KeyboardShortcuts: user.KeyboardShortcuts,
+		Username:                  user.Username,
+		Theme:                     user.Theme,
+		Language:                  user.Language,
+		Timezone:                  user.Timezone,
+		EntryDirection:            user.EntryDirection,
+		KeyboardShortcuts:         user.KeyboardShortcuts,
+		DuplicatePolicy:           user.DuplicatePolicy,
+		DuplicateMatchTitle:       user.DuplicateMatchTitle,
+		MarkReadOnMediaCompletion: user.MarkReadOnMediaCompletion,
 	}
 
 	timezones, err := h.store.Timezones()
@@ -0,0 +1,55 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showCreateSiteRulePage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	// The form can be prefilled to override a built-in rule.
+	siteRuleForm := &form.SiteRuleForm{
+		Domain:       model.NormalizeDomain(request.QueryStringParam(r, "domain", "")),
+		ScraperRules: request.QueryStringParam(r, "scraper_rules", ""),
+		RewriteRules: request.QueryStringParam(r, "rewrite_rules", ""),
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", siteRuleForm)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("create_site_rule"))
+}
+
+// siteRuleByID returns the site rule when it is global or owned by the user.
+func (h *handler) siteRuleByID(user *model.User, siteRuleID int64) (*model.SiteRule, error) {
+	rule, err := h.store.SiteRuleByID(siteRuleID)
+	if err != nil || rule == nil {
+		return nil, err
+	}
+
+	if !rule.Global && rule.UserID != user.ID {
+		return nil, nil
+	}
+
+	return rule, nil
+}
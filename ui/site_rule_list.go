@@ -0,0 +1,82 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"sort"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/scraper"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showSiteRulesPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	siteRules, err := h.store.SiteRules(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("siteRules", siteRules)
+	view.Set("builtinRules", builtinSiteRules(siteRules))
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("site_rules"))
+}
+
+// builtinSiteRules returns the rules built into the application that are not overridden by a site rule.
+func builtinSiteRules(siteRules model.SiteRules) model.SiteRules {
+	overridden := make(map[string]bool)
+	for _, rule := range siteRules {
+		overridden[rule.Domain] = true
+	}
+
+	rulesByDomain := make(map[string]*model.SiteRule)
+	getRule := func(domain string) *model.SiteRule {
+		if _, found := rulesByDomain[domain]; !found {
+			rulesByDomain[domain] = &model.SiteRule{Domain: domain}
+		}
+		return rulesByDomain[domain]
+	}
+
+	for domain, rules := range scraper.PredefinedRules() {
+		if !overridden[domain] {
+			getRule(domain).ScraperRules = rules
+		}
+	}
+
+	for domain, rules := range rewrite.PredefinedRules() {
+		if !overridden[domain] {
+			getRule(domain).RewriteRules = rules
+		}
+	}
+
+	builtinRules := make(model.SiteRules, 0, len(rulesByDomain))
+	for _, rule := range rulesByDomain {
+		builtinRules = append(builtinRules, rule)
+	}
+
+	sort.Slice(builtinRules, func(i, j int) bool {
+		return builtinRules[i].Domain < builtinRules[j].Domain
+	})
+
+	return builtinRules
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+)
+
+func (h *handler) removeSiteRule(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	rule, err := h.siteRuleByID(user, request.RouteInt64Param(r, "siteRuleID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if rule.Global && !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	if err := h.store.RemoveSiteRule(rule.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "siteRules"))
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) saveSiteRule(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	siteRuleForm := form.NewSiteRuleForm(r)
+	if siteRuleForm.Global && !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", siteRuleForm)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := siteRuleForm.Validate(); err != nil {
+		view.Set("errorMessage", err)
+		html.OK(w, r, view.Render("create_site_rule"))
+		return
+	}
+
+	rule := siteRuleForm.Merge(&model.SiteRule{Global: siteRuleForm.Global})
+	if !rule.Global {
+		rule.UserID = user.ID
+	}
+
+	if h.store.AnotherSiteRuleExists(rule) {
+		view.Set("errorMessage", "error.site_rule_already_exists")
+		html.OK(w, r, view.Render("create_site_rule"))
+		return
+	}
+
+	if err := h.store.CreateSiteRule(rule); err != nil {
+		logger.Error("[UI:SaveSiteRule] %v", err)
+		view.Set("errorMessage", "error.unable_to_create_site_rule")
+		html.OK(w, r, view.Render("create_site_rule"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "siteRules"))
+}
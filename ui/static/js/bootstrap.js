@@ -79,6 +79,8 @@ document.addEventListener("DOMContentLoaded", function() {
         LinkStateHandler.flip(event.target);
     }, true);
 
+    MediaPlayerHandler.initialize();
+
     if (document.documentElement.clientWidth < 600) {
         let menuHandler = new MenuHandler();
         mouseHandler.onClick(".logo", () => menuHandler.toggleMainMenu());
@@ -0,0 +1,67 @@
+class MediaPlayerHandler {
+    constructor(element) {
+        this.element = element;
+        this.lastSavedPosition = parseInt(element.dataset.lastPosition, 10) || 0;
+    }
+
+    listen() {
+        this.element.addEventListener("loadedmetadata", () => this.restorePosition());
+        this.element.addEventListener("timeupdate", () => {
+            // Saving the position on each event would flood the server.
+            if (Math.abs(this.element.currentTime - this.lastSavedPosition) >= 10) {
+                this.savePosition(this.element.currentTime);
+            }
+        });
+        this.element.addEventListener("pause", () => this.savePosition(this.element.currentTime));
+        this.element.addEventListener("ended", () => {
+            this.savePosition(0);
+            this.markEntryAsRead();
+        });
+
+        let enclosureElement = DomHelper.findParent(this.element, "entry-enclosure");
+        let speedElement = enclosureElement ? enclosureElement.querySelector("select[data-playback-speed]") : null;
+        if (speedElement) {
+            speedElement.addEventListener("change", () => {
+                this.element.playbackRate = parseFloat(speedElement.value);
+            });
+        }
+    }
+
+    restorePosition() {
+        let position = parseInt(this.element.dataset.lastPosition, 10) || 0;
+
+        // Start from the beginning when the previous playback was almost over.
+        if (position > 0 && (isNaN(this.element.duration) || position < this.element.duration - 5)) {
+            this.element.currentTime = position;
+        }
+    }
+
+    savePosition(currentTime) {
+        let position = Math.floor(currentTime);
+        this.lastSavedPosition = position;
+
+        let request = new RequestBuilder(this.element.dataset.progressionUrl);
+        request.withBody({media_progression: position});
+        request.execute();
+    }
+
+    markEntryAsRead() {
+        if (this.element.dataset.markReadOnCompletion !== "true") {
+            return;
+        }
+
+        // Other players of the same entry must not mark it as read again.
+        document.querySelectorAll("[data-mark-read-on-completion]").forEach((element) => {
+            delete element.dataset.markReadOnCompletion;
+        });
+
+        EntryHandler.updateEntriesStatus([parseInt(this.element.dataset.entryId, 10)], "read");
+    }
+
+    static initialize() {
+        document.querySelectorAll("audio[data-progression-url], video[data-progression-url]").forEach((element) => {
+            let handler = new MediaPlayerHandler(element);
+            handler.listen();
+        });
+    }
+}
@@ -55,6 +55,9 @@ func (h *handler) showChooseSubscriptionPage(w http.ResponseWriter, r *http.Requ
 		subscriptionForm.UserAgent,
 		subscriptionForm.Username,
 		subscriptionForm.Password,
+		subscriptionForm.ProxyURL,
+		subscriptionForm.RequestHeaders(),
+		subscriptionForm.Cookie,
 	)
 	if err != nil {
 		view.Set("form", subscriptionForm)
@@ -54,6 +54,9 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		subscriptionForm.UserAgent,
 		subscriptionForm.Username,
 		subscriptionForm.Password,
+		subscriptionForm.ProxyURL,
+		subscriptionForm.RequestHeaders(),
+		subscriptionForm.Cookie,
 	)
 	if findErr != nil {
 		logger.Error("[UI:SubmitSubscription] %s", findErr)
@@ -80,6 +83,9 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 			subscriptionForm.UserAgent,
 			subscriptionForm.Username,
 			subscriptionForm.Password,
+		subscriptionForm.ProxyURL,
+			subscriptionForm.RequestHeaders(),
+			subscriptionForm.Cookie,
 		)
 		if err != nil {
 			v.Set("form", subscriptionForm)
@@ -51,6 +51,11 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/starred", handler.showStarredPage).Name("starred").Methods("GET")
 	uiRouter.HandleFunc("/starred/entry/{entryID}", handler.showStarredEntryPage).Name("starredEntry").Methods("GET")
 
+	// Tag pages.
+	uiRouter.HandleFunc("/tags", handler.showTagListPage).Name("tags").Methods("GET")
+	uiRouter.HandleFunc("/tag/{tagID}/entries", handler.showTagEntriesPage).Name("tagEntries").Methods("GET")
+	uiRouter.HandleFunc("/tag/{tagID}/entry/{entryID}", handler.showTagEntryPage).Name("tagEntry").Methods("GET")
+
 	// Search pages.
 	uiRouter.HandleFunc("/search", handler.showSearchEntriesPage).Name("searchEntries").Methods("GET")
 	uiRouter.HandleFunc("/search/entry/{entryID}", handler.showSearchEntryPage).Name("searchEntry").Methods("GET")
@@ -84,8 +89,11 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/entry/status", handler.updateEntriesStatus).Name("updateEntriesStatus").Methods("POST")
 	uiRouter.HandleFunc("/entry/save/{entryID}", handler.saveEntry).Name("saveEntry").Methods("POST")
 	uiRouter.HandleFunc("/entry/download/{entryID}", handler.fetchContent).Name("fetchContent").Methods("POST")
-	uiRouter.HandleFunc("/proxy/{encodedURL}", handler.imageProxy).Name("proxy").Methods("GET")
+	uiRouter.HandleFunc("/entry/revisions/{entryID}", handler.showEntryRevisionsPage).Name("entryRevisions").Methods("GET")
+	uiRouter.HandleFunc("/proxy/{feedID}/{encodedURL}", handler.imageProxy).Name("proxy").Methods("GET")
 	uiRouter.HandleFunc("/entry/bookmark/{entryID}", handler.toggleBookmark).Name("toggleBookmark").Methods("POST")
+	uiRouter.HandleFunc("/entry/tags/{entryID}", handler.updateEntryTags).Name("updateEntryTags").Methods("POST")
+	uiRouter.HandleFunc("/entry/enclosure/{enclosureID}/progression", handler.saveEnclosureProgression).Name("saveEnclosureProgression").Methods("POST")
 
 	// User pages.
 	uiRouter.HandleFunc("/users", handler.showUsersPage).Name("users").Methods("GET")
@@ -104,6 +112,23 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/integration/pocket/callback", handler.pocketCallback).Name("pocketCallback").Methods("GET")
 	uiRouter.HandleFunc("/about", handler.showAboutPage).Name("about").Methods("GET")
 
+	// Rule pages.
+	uiRouter.HandleFunc("/rules", handler.showRulesPage).Name("rules").Methods("GET")
+	uiRouter.HandleFunc("/rule/create", handler.showCreateRulePage).Name("createRule").Methods("GET")
+	uiRouter.HandleFunc("/rule/save", handler.saveRule).Name("saveRule").Methods("POST")
+	uiRouter.HandleFunc("/rule/{ruleID}/edit", handler.showEditRulePage).Name("editRule").Methods("GET")
+	uiRouter.HandleFunc("/rule/{ruleID}/update", handler.updateRule).Name("updateRule").Methods("POST")
+	uiRouter.HandleFunc("/rule/{ruleID}/remove", handler.removeRule).Name("removeRule").Methods("POST")
+	uiRouter.HandleFunc("/rule/{ruleID}/apply", handler.applyRule).Name("applyRule").Methods("POST")
+
+	// Site rule pages.
+	uiRouter.HandleFunc("/site-rules", handler.showSiteRulesPage).Name("siteRules").Methods("GET")
+	uiRouter.HandleFunc("/site-rule/create", handler.showCreateSiteRulePage).Name("createSiteRule").Methods("GET")
+	uiRouter.HandleFunc("/site-rule/save", handler.saveSiteRule).Name("saveSiteRule").Methods("POST")
+	uiRouter.HandleFunc("/site-rule/{siteRuleID}/edit", handler.showEditSiteRulePage).Name("editSiteRule").Methods("GET")
+	uiRouter.HandleFunc("/site-rule/{siteRuleID}/update", handler.updateSiteRule).Name("updateSiteRule").Methods("POST")
+	uiRouter.HandleFunc("/site-rule/{siteRuleID}/remove", handler.removeSiteRule).Name("removeSiteRule").Methods("POST")
+
 	// Session pages.
 	uiRouter.HandleFunc("/sessions", handler.showSessionsPage).Name("sessions").Methods("GET")
 	uiRouter.HandleFunc("/sessions/{sessionID}/remove", handler.removeSession).Name("removeSession").Methods("POST")
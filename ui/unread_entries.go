@@ -34,12 +34,22 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if offset >= countUnread {
+	builder = h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithStatus(model.EntryStatusUnread)
+	readingTime := withReadingTimeFilter(r, builder)
+
+	count := countUnread
+	if readingTime != "" {
+		if count, err = builder.CountEntries(); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	if offset >= count {
 		offset = 0
 	}
 
-	builder = h.store.NewEntryQueryBuilder(user.ID)
-	builder.WithStatus(model.EntryStatusUnread)
 	builder.WithOrder(model.DefaultSortingOrder)
 	builder.WithDirection(user.EntryDirection)
 	builder.WithOffset(offset)
@@ -51,7 +61,10 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	view.Set("entries", entries)
-	view.Set("pagination", getPagination(route.Path(h.router, "unread"), countUnread, offset))
+	pagination := getPagination(route.Path(h.router, "unread"), count, offset)
+	pagination.ReadingTime = readingTime
+	view.Set("pagination", pagination)
+	view.Set("readingTime", readingTime)
 	view.Set("menu", "unread")
 	view.Set("user", user)
 	view.Set("countUnread", countUnread)
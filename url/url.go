@@ -69,3 +69,26 @@ func Domain(websiteURL string) string {
 
 	return parsedURL.Host
 }
+
+// Normalize returns a canonical form of the given URL, used to compare URLs.
+// The scheme and the host are lowercased, the default port and the fragment are removed.
+func Normalize(websiteURL string) string {
+	u, err := url.Parse(strings.TrimSpace(websiteURL))
+	if err != nil {
+		return websiteURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+
+	if (u.Scheme == "http" && u.Port() == "80") || (u.Scheme == "https" && u.Port() == "443") {
+		u.Host = u.Hostname()
+	}
+
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	return u.String()
+}
@@ -71,3 +71,25 @@ func TestDomain(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalize(t *testing.T) {
+	scenarios := map[string]string{
+		"https://example.org/feed.xml":     "https://example.org/feed.xml",
+		"HTTPS://Example.ORG/feed.xml":     "https://example.org/feed.xml",
+		"https://example.org:443/feed.xml": "https://example.org/feed.xml",
+		"http://example.org:80/feed.xml":   "http://example.org/feed.xml",
+		"http://example.org:8080/feed.xml": "http://example.org:8080/feed.xml",
+		"https://example.org/feed.xml#top": "https://example.org/feed.xml",
+		"https://example.org":              "https://example.org/",
+		"https://example.org/Feed.xml?a=1": "https://example.org/Feed.xml?a=1",
+		" https://example.org/feed.xml ":   "https://example.org/feed.xml",
+		"https://example|org/":             "https://example|org/",
+	}
+
+	for input, expected := range scenarios {
+		actual := Normalize(input)
+		if actual != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
@@ -5,31 +5,193 @@
 package worker // import "miniflux.app/worker"
 
 import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/feed"
 )
 
 // Pool handles a pool of workers.
+//
+// Jobs are queued per host and dispatched to the workers without exceeding
+// the concurrency limit and the minimum delay between requests to the same host.
+// Subscriptions to the same feed are grouped to be refreshed with a single request.
 type Pool struct {
-	queue chan model.Job
+	queue          chan model.JobList
+	wakeup         chan bool
+	mutex          sync.Mutex
+	hosts          map[string]*hostQueue
+	queued         map[int64]bool
+	maxConcurrency int
+	delay          time.Duration
+}
+
+type hostQueue struct {
+	groups      []model.JobList
+	running     int
+	lastRequest time.Time
 }
 
 // Push send a list of jobs to the queue.
+// Feeds that are already waiting in the queue or being refreshed are ignored.
 func (p *Pool) Push(jobs model.JobList) {
+	p.mutex.Lock()
+
+	hosts := make(map[string]bool)
+	for _, job := range jobs {
+		if p.queued[job.FeedID] {
+			continue
+		}
+
+		host := jobHost(job)
+		queue, found := p.hosts[host]
+		if !found {
+			queue = &hostQueue{}
+			p.hosts[host] = queue
+		}
+
+		queue.add(job)
+		p.queued[job.FeedID] = true
+		hosts[host] = true
+	}
+
+	for host := range hosts {
+		queue := p.hosts[host]
+		if len(queue.groups) > p.maxConcurrency {
+			logger.Info("[Pool] Host %q has %d jobs waiting in the queue (%d running)", host, len(queue.groups), queue.running)
+		} else {
+			logger.Debug("[Pool] Host %q has %d jobs waiting in the queue (%d running)", host, len(queue.groups), queue.running)
+		}
+	}
+
+	p.mutex.Unlock()
+	p.notify()
+}
+
+func (p *Pool) done(jobs model.JobList) {
+	p.mutex.Lock()
+	if queue, found := p.hosts[jobHost(jobs[0])]; found {
+		queue.running--
+	}
 	for _, job := range jobs {
-		p.queue <- job
+		delete(p.queued, job.FeedID)
+	}
+	p.mutex.Unlock()
+	p.notify()
+}
+
+func (p *Pool) notify() {
+	select {
+	case p.wakeup <- true:
+	default:
+	}
+}
+
+// nextJob returns the next job that can be sent to the workers.
+// When no job is ready, it returns how long to wait before the next host becomes available (zero means wait for new jobs).
+func (p *Pool) nextJob(now time.Time) (model.JobList, bool, time.Duration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var wait time.Duration
+	for host, queue := range p.hosts {
+		elapsed := now.Sub(queue.lastRequest)
+
+		if len(queue.groups) == 0 {
+			if queue.running == 0 && elapsed >= p.delay {
+				delete(p.hosts, host)
+			}
+			continue
+		}
+
+		if queue.running >= p.maxConcurrency {
+			continue
+		}
+
+		if elapsed < p.delay {
+			if remaining := p.delay - elapsed; wait == 0 || remaining < wait {
+				wait = remaining
+			}
+			continue
+		}
+
+		jobs := queue.groups[0]
+		queue.groups = queue.groups[1:]
+		queue.running++
+		queue.lastRequest = now
+		return jobs, true, 0
+	}
+
+	return nil, false, wait
+}
+
+func (p *Pool) dispatch() {
+	for {
+		jobs, found, wait := p.nextJob(time.Now())
+		if found {
+			p.queue <- jobs
+			continue
+		}
+
+		if wait > 0 {
+			select {
+			case <-p.wakeup:
+			case <-time.After(wait):
+			}
+		} else {
+			<-p.wakeup
+		}
+	}
+}
+
+// add appends the job to the group of jobs sharing the same request, or creates a new group.
+func (q *hostQueue) add(job model.Job) {
+	key := job.FetchKey()
+	for i, jobs := range q.groups {
+		if jobs[0].FetchKey() == key {
+			q.groups[i] = append(jobs, job)
+			return
+		}
+	}
+	q.groups = append(q.groups, model.JobList{job})
+}
+
+func jobHost(job model.Job) string {
+	u, err := url.Parse(job.FeedURL)
+	if err != nil {
+		return job.FeedURL
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+func newPool(maxConcurrency int, delay time.Duration) *Pool {
+	return &Pool{
+		queue:          make(chan model.JobList),
+		wakeup:         make(chan bool, 1),
+		hosts:          make(map[string]*hostQueue),
+		queued:         make(map[int64]bool),
+		maxConcurrency: maxConcurrency,
+		delay:          delay,
 	}
 }
 
 // NewPool creates a pool of background workers.
-func NewPool(feedHandler *feed.Handler, nbWorkers int) *Pool {
-	workerPool := &Pool{
-		queue: make(chan model.Job),
+// Each host gets at most hostConcurrency workers, and two requests to the same host are separated by at least hostDelay.
+func NewPool(feedHandler *feed.Handler, nbWorkers, hostConcurrency int, hostDelay time.Duration) *Pool {
+	if hostConcurrency < 1 {
+		hostConcurrency = nbWorkers
 	}
 
+	workerPool := newPool(hostConcurrency, hostDelay)
+	go workerPool.dispatch()
+
 	for i := 0; i < nbWorkers; i++ {
 		worker := &Worker{id: i, feedHandler: feedHandler}
-		go worker.Run(workerPool.queue)
+		go worker.Run(workerPool)
 	}
 
 	return workerPool
@@ -0,0 +1,118 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package worker // import "miniflux.app/worker"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestPoolRespectsHostConcurrency(t *testing.T) {
+	pool := newPool(2, 0)
+	pool.Push(model.JobList{
+		{FeedID: 1, FeedURL: "https://example.org/feed1.xml"},
+		{FeedID: 2, FeedURL: "https://example.org/feed2.xml"},
+		{FeedID: 3, FeedURL: "https://example.org/feed3.xml"},
+	})
+
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		if _, found, _ := pool.nextJob(now); !found {
+			t.Fatalf(`Job #%d should be dispatched`, i+1)
+		}
+	}
+
+	if _, found, _ := pool.nextJob(now); found {
+		t.Fatal(`The third job should wait for a running job to finish`)
+	}
+
+	pool.done(model.JobList{{FeedID: 1, FeedURL: "https://example.org/feed1.xml"}})
+
+	jobs, found, _ := pool.nextJob(now)
+	if !found || jobs[0].FeedID != 3 {
+		t.Fatalf(`The third job should be dispatched, got %+v`, jobs)
+	}
+}
+
+func TestPoolRespectsHostDelay(t *testing.T) {
+	pool := newPool(10, time.Minute)
+	pool.Push(model.JobList{
+		{FeedID: 1, FeedURL: "https://example.org/feed1.xml"},
+		{FeedID: 2, FeedURL: "https://EXAMPLE.org/feed2.xml"},
+	})
+
+	now := time.Now()
+	if _, found, _ := pool.nextJob(now); !found {
+		t.Fatal(`The first job should be dispatched`)
+	}
+
+	_, found, wait := pool.nextJob(now.Add(10 * time.Second))
+	if found {
+		t.Fatal(`The second job should be delayed`)
+	}
+
+	if wait != 50*time.Second {
+		t.Fatalf(`Unexpected delay, got %v instead of %v`, wait, 50*time.Second)
+	}
+
+	if _, found, _ := pool.nextJob(now.Add(time.Minute)); !found {
+		t.Fatal(`The second job should be dispatched after the delay`)
+	}
+}
+
+func TestPoolDoesNotDelayOtherHosts(t *testing.T) {
+	pool := newPool(1, time.Minute)
+	pool.Push(model.JobList{
+		{FeedID: 1, FeedURL: "https://example.org/feed.xml"},
+		{FeedID: 2, FeedURL: "https://example.com/feed.xml"},
+	})
+
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		if _, found, _ := pool.nextJob(now); !found {
+			t.Fatalf(`Job #%d should be dispatched`, i+1)
+		}
+	}
+}
+
+func TestPoolIgnoresQueuedFeeds(t *testing.T) {
+	pool := newPool(10, 0)
+	pool.Push(model.JobList{{FeedID: 1, FeedURL: "https://example.org/feed.xml"}})
+	pool.Push(model.JobList{{FeedID: 1, FeedURL: "https://example.org/feed.xml"}})
+
+	now := time.Now()
+	if _, found, _ := pool.nextJob(now); !found {
+		t.Fatal(`The job should be dispatched`)
+	}
+
+	if _, found, _ := pool.nextJob(now); found {
+		t.Fatal(`The same feed should not be queued twice`)
+	}
+}
+
+func TestPoolGroupsSubscriptionsToTheSameFeed(t *testing.T) {
+	pool := newPool(10, 0)
+	pool.Push(model.JobList{
+		{UserID: 1, FeedID: 1, FeedURL: "https://example.org/feed.xml"},
+		{UserID: 2, FeedID: 2, FeedURL: "https://EXAMPLE.org/feed.xml"},
+		{UserID: 3, FeedID: 3, FeedURL: "https://example.org/feed.xml", Username: "alice", Password: "secret"},
+	})
+
+	jobs, found, _ := pool.nextJob(time.Now())
+	if !found {
+		t.Fatal(`The jobs should be dispatched`)
+	}
+
+	if len(jobs) != 2 || jobs[0].FeedID != 1 || jobs[1].FeedID != 2 {
+		t.Fatalf(`The subscriptions without credentials should be grouped, got %+v`, jobs)
+	}
+
+	jobs, found, _ = pool.nextJob(time.Now())
+	if !found || len(jobs) != 1 || jobs[0].FeedID != 3 {
+		t.Fatalf(`The subscription with credentials should be refreshed separately, got %+v`, jobs)
+	}
+}
@@ -6,7 +6,6 @@ package worker // import "miniflux.app/worker"
 
 import (
 	"miniflux.app/logger"
-	"miniflux.app/model"
 	"miniflux.app/reader/feed"
 )
 
@@ -16,17 +15,24 @@ type Worker struct {
 	feedHandler *feed.Handler
 }
 
-// Run wait for a job and refresh the given feed.
-func (w *Worker) Run(c chan model.Job) {
+// Run wait for a job and refresh the given feeds.
+func (w *Worker) Run(pool *Pool) {
 	logger.Debug("[Worker] #%d started", w.id)
 
 	for {
-		job := <-c
-		logger.Debug("[Worker #%d] got userID=%d, feedID=%d", w.id, job.UserID, job.FeedID)
+		jobs := <-pool.queue
+		if len(jobs) == 1 {
+			logger.Debug("[Worker #%d] got userID=%d, feedID=%d", w.id, jobs[0].UserID, jobs[0].FeedID)
 
-		err := w.feedHandler.RefreshFeed(job.UserID, job.FeedID)
-		if err != nil {
-			logger.Error("[Worker] %v", err)
+			err := w.feedHandler.RefreshFeed(jobs[0].UserID, jobs[0].FeedID)
+			if err != nil {
+				logger.Error("[Worker] %v", err)
+			}
+		} else {
+			logger.Debug("[Worker #%d] got %d subscriptions for %s", w.id, len(jobs), jobs[0].FeedURL)
+			w.feedHandler.RefreshFeeds(jobs)
 		}
+
+		pool.done(jobs)
 	}
 }